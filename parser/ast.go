@@ -108,16 +108,25 @@ func (p *BinaryExpr) String(level int) string {
 	if p.Operation != opTypeCast {
 		builder.WriteByte(' ')
 	}
+	if p.HasGlobal {
+		builder.WriteString("GLOBAL ")
+	}
 	if p.HasNot {
 		builder.WriteString("NOT ")
-	} else if p.HasGlobal {
-		builder.WriteString("GLOBAL ")
 	}
 	builder.WriteString(string(p.Operation))
 	if p.Operation != opTypeCast {
 		builder.WriteByte(' ')
 	}
-	builder.WriteString(p.RightExpr.String(level))
+	if isQuery(p.RightExpr) {
+		// the subquery of IN or comparison needs the brackets
+		builder.WriteByte('(')
+		builder.WriteString(p.RightExpr.String(level + 1))
+		builder.WriteString(NewLine(level))
+		builder.WriteByte(')')
+	} else {
+		builder.WriteString(p.RightExpr.String(level))
+	}
 	return builder.String()
 }
 
@@ -133,6 +142,99 @@ func (p *BinaryExpr) Accept(visitor ASTVisitor) error {
 	return visitor.VisitBinaryExpr(p)
 }
 
+// LambdaExpr is the lambda function of higher-order functions like
+// arrayMap(x -> x * 2, arr) and arrayFilter((k, v) -> v > 0, keys, vals).
+type LambdaExpr struct {
+	ParamsPos Pos
+	Params    []*Ident
+	Body      Expr
+}
+
+func (l *LambdaExpr) Pos() Pos {
+	return l.ParamsPos
+}
+
+func (l *LambdaExpr) End() Pos {
+	return l.Body.End()
+}
+
+func (l *LambdaExpr) String(level int) string {
+	var builder strings.Builder
+	if len(l.Params) == 1 {
+		builder.WriteString(l.Params[0].String(level))
+	} else {
+		builder.WriteByte('(')
+		for i, param := range l.Params {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(param.String(level))
+		}
+		builder.WriteByte(')')
+	}
+	builder.WriteString(" -> ")
+	builder.WriteString(l.Body.String(level))
+	return builder.String()
+}
+
+func (l *LambdaExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(l)
+	defer visitor.leave(l)
+	for _, param := range l.Params {
+		if err := param.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if err := l.Body.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitLambdaExpr(l)
+}
+
+// BetweenExpr is `expr [NOT] BETWEEN lower AND upper`.
+type BetweenExpr struct {
+	Expr   Expr
+	HasNot bool
+	Lower  Expr
+	Upper  Expr
+}
+
+func (b *BetweenExpr) Pos() Pos {
+	return b.Expr.Pos()
+}
+
+func (b *BetweenExpr) End() Pos {
+	return b.Upper.End()
+}
+
+func (b *BetweenExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(b.Expr.String(level))
+	if b.HasNot {
+		builder.WriteString(" NOT")
+	}
+	builder.WriteString(" BETWEEN ")
+	builder.WriteString(b.Lower.String(level))
+	builder.WriteString(" AND ")
+	builder.WriteString(b.Upper.String(level))
+	return builder.String()
+}
+
+func (b *BetweenExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(b)
+	defer visitor.leave(b)
+	if err := b.Expr.Accept(visitor); err != nil {
+		return err
+	}
+	if err := b.Lower.Accept(visitor); err != nil {
+		return err
+	}
+	if err := b.Upper.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitBetweenExpr(b)
+}
+
 type JoinTableExpr struct {
 	Table        *TableExpr
 	StatementEnd Pos
@@ -743,7 +845,7 @@ func (a *AlterTableRenameColumn) Accept(visitor ASTVisitor) error {
 type AlterTableModifyTTL struct {
 	ModifyPos    Pos
 	StatementEnd Pos
-	TTL          *TTLExpr
+	TTL          *TTLExprList
 }
 
 func (a *AlterTableModifyTTL) Pos() Pos {
@@ -761,7 +863,6 @@ func (a *AlterTableModifyTTL) AlterType() string {
 func (a *AlterTableModifyTTL) String(level int) string {
 	var builder strings.Builder
 	builder.WriteString("MODIFY ")
-	builder.WriteString("TTL ")
 	builder.WriteString(a.TTL.String(level))
 	return builder.String()
 }
@@ -862,689 +963,3594 @@ func (a *AlterTableReplacePartition) Accept(visitor ASTVisitor) error {
 	return visitor.VisitAlterTableReplacePartition(a)
 }
 
-type RemovePropertyType struct {
-	RemovePos Pos
+type AlterTableUpdate struct {
+	UpdatePos   Pos
+	Assignments []*UpdateAssignment
+	InPartition *PartitionExpr
+	WhereExpr   Expr
+}
 
-	PropertyType Expr
+func (a *AlterTableUpdate) Pos() Pos {
+	return a.UpdatePos
 }
 
-func (a *RemovePropertyType) Pos() Pos {
-	return a.RemovePos
+func (a *AlterTableUpdate) End() Pos {
+	return a.WhereExpr.End()
 }
 
-func (a *RemovePropertyType) End() Pos {
-	return a.PropertyType.End()
+func (a *AlterTableUpdate) AlterType() string {
+	return "UPDATE"
 }
 
-func (a *RemovePropertyType) String(level int) string {
+func (a *AlterTableUpdate) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString(" REMOVE ")
-	builder.WriteString(a.PropertyType.String(level))
+	builder.WriteString("UPDATE ")
+	for i, assignment := range a.Assignments {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(assignment.String(level))
+	}
+	if a.InPartition != nil {
+		builder.WriteString(" IN ")
+		builder.WriteString(a.InPartition.String(level))
+	}
+	builder.WriteString(" WHERE ")
+	builder.WriteString(a.WhereExpr.String(level))
 	return builder.String()
 }
 
-func (a *RemovePropertyType) Accept(visitor ASTVisitor) error {
+func (a *AlterTableUpdate) Accept(visitor ASTVisitor) error {
 	visitor.enter(a)
 	defer visitor.leave(a)
-	if err := a.PropertyType.Accept(visitor); err != nil {
+	for _, assignment := range a.Assignments {
+		if err := assignment.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if a.InPartition != nil {
+		if err := a.InPartition.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if err := a.WhereExpr.Accept(visitor); err != nil {
 		return err
 	}
-	return visitor.VisitRemovePropertyType(a)
+	return visitor.VisitAlterTableUpdate(a)
 }
 
-type TableIndex struct {
-	IndexPos Pos
+type AlterTableDelete struct {
+	DeletePos   Pos
+	InPartition *PartitionExpr
+	WhereExpr   Expr
+}
 
-	Name        *NestedIdentifier
-	ColumnExpr  Expr
-	ColumnType  Expr
-	Granularity *NumberLiteral
+func (a *AlterTableDelete) Pos() Pos {
+	return a.DeletePos
 }
 
-func (a *TableIndex) Pos() Pos {
-	return a.IndexPos
+func (a *AlterTableDelete) End() Pos {
+	return a.WhereExpr.End()
 }
 
-func (a *TableIndex) End() Pos {
-	return a.Granularity.End()
+func (a *AlterTableDelete) AlterType() string {
+	return "DELETE"
 }
 
-func (a *TableIndex) String(level int) string {
+func (a *AlterTableDelete) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString(a.Name.String(0))
-	builder.WriteString(a.ColumnExpr.String(level))
-	builder.WriteString("TYPE")
-	builder.WriteByte(' ')
-	builder.WriteString(a.ColumnType.String(level))
-	builder.WriteByte(' ')
-	builder.WriteString("GRANULARITY")
-	builder.WriteByte(' ')
-	builder.WriteString(a.Granularity.String(level))
+	builder.WriteString("DELETE")
+	if a.InPartition != nil {
+		builder.WriteString(" IN ")
+		builder.WriteString(a.InPartition.String(level))
+	}
+	builder.WriteString(" WHERE ")
+	builder.WriteString(a.WhereExpr.String(level))
 	return builder.String()
 }
 
-func (a *TableIndex) Accept(visitor ASTVisitor) error {
+func (a *AlterTableDelete) Accept(visitor ASTVisitor) error {
 	visitor.enter(a)
 	defer visitor.leave(a)
-	if err := a.Name.Accept(visitor); err != nil {
-		return err
-	}
-	if err := a.ColumnExpr.Accept(visitor); err != nil {
-		return err
-	}
-	if err := a.ColumnType.Accept(visitor); err != nil {
-		return err
+	if a.InPartition != nil {
+		if err := a.InPartition.Accept(visitor); err != nil {
+			return err
+		}
 	}
-	if err := a.Granularity.Accept(visitor); err != nil {
+	if err := a.WhereExpr.Accept(visitor); err != nil {
 		return err
 	}
-	return visitor.VisitTableIndex(a)
+	return visitor.VisitAlterTableDelete(a)
 }
 
-type Ident struct {
-	Name      string
-	QuoteType int
-	NamePos   Pos
-	NameEnd   Pos
+type UpdateAssignment struct {
+	Column *NestedIdentifier
+	Expr   Expr
 }
 
-func (i *Ident) Pos() Pos {
-	return i.NamePos
+func (u *UpdateAssignment) Pos() Pos {
+	return u.Column.Pos()
 }
 
-func (i *Ident) End() Pos {
-	return i.NameEnd
+func (u *UpdateAssignment) End() Pos {
+	return u.Expr.End()
 }
 
-func (i *Ident) String(int) string {
-	if i.QuoteType == BackTicks {
-		return "`" + i.Name + "`"
-	} else if i.QuoteType == DoubleQuote {
-		return `"` + i.Name + `"`
+func (u *UpdateAssignment) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(u.Column.String(level))
+	builder.WriteString(" = ")
+	builder.WriteString(u.Expr.String(level))
+	return builder.String()
+}
+
+func (u *UpdateAssignment) Accept(visitor ASTVisitor) error {
+	visitor.enter(u)
+	defer visitor.leave(u)
+	if err := u.Column.Accept(visitor); err != nil {
+		return err
 	}
-	return i.Name
+	if err := u.Expr.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitUpdateAssignment(u)
 }
 
-func (i *Ident) Accept(visitor ASTVisitor) error {
-	visitor.enter(i)
-	defer visitor.leave(i)
-	return visitor.VisitIdent(i)
+type AlterTableMovePartition struct {
+	MovePos   Pos
+	Partition *PartitionExpr
+	Part      *StringLiteral // MOVE PART 'name' instead of a partition
+	ToType    string         // DISK, VOLUME or TABLE
+	To        *StringLiteral
+	ToTable   *TableIdentifier
 }
 
-type UUID struct {
-	Value *StringLiteral
+func (a *AlterTableMovePartition) Pos() Pos {
+	return a.MovePos
 }
 
-func (u *UUID) Pos() Pos {
-	return u.Value.LiteralPos
+func (a *AlterTableMovePartition) End() Pos {
+	if a.ToTable != nil {
+		return a.ToTable.End()
+	}
+	return a.To.End()
 }
 
-func (u *UUID) End() Pos {
-	return u.Value.LiteralEnd
+func (a *AlterTableMovePartition) AlterType() string {
+	return "MOVE_PARTITION"
 }
 
-func (u *UUID) String(level int) string {
-	return "UUID " + u.Value.String(level)
+func (a *AlterTableMovePartition) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("MOVE ")
+	if a.Part != nil {
+		builder.WriteString("PART ")
+		builder.WriteString(a.Part.String(level))
+	} else {
+		builder.WriteString(a.Partition.String(level))
+	}
+	builder.WriteString(" TO ")
+	builder.WriteString(a.ToType)
+	builder.WriteByte(' ')
+	if a.ToTable != nil {
+		builder.WriteString(a.ToTable.String(level))
+	} else {
+		builder.WriteString(a.To.String(level))
+	}
+	return builder.String()
 }
 
-func (u *UUID) Accept(visitor ASTVisitor) error {
-	visitor.enter(u)
-	defer visitor.leave(u)
-	return visitor.VisitUUID(u)
+func (a *AlterTableMovePartition) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if a.Partition != nil {
+		if err := a.Partition.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if a.Part != nil {
+		if err := a.Part.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if a.To != nil {
+		if err := a.To.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if a.ToTable != nil {
+		if err := a.ToTable.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterTableMovePartition(a)
 }
 
-type CreateDatabase struct {
-	CreatePos    Pos // position of CREATE keyword
-	StatementEnd Pos
-	Name         Expr
-	IfNotExists  bool // true if 'IF NOT EXISTS' is specified
-	OnCluster    *OnClusterExpr
-	Engine       *EngineExpr
+type AlterTableFetchPartition struct {
+	FetchPos  Pos
+	Partition *PartitionExpr
+	Part      *StringLiteral // FETCH PART 'name' instead of a partition
+	From      *StringLiteral
 }
 
-func (c *CreateDatabase) Pos() Pos {
-	return c.CreatePos
+func (a *AlterTableFetchPartition) Pos() Pos {
+	return a.FetchPos
 }
 
-func (c *CreateDatabase) End() Pos {
-	return c.StatementEnd
+func (a *AlterTableFetchPartition) End() Pos {
+	return a.From.End()
 }
 
-func (c *CreateDatabase) Type() string {
-	return "DATABASE"
+func (a *AlterTableFetchPartition) AlterType() string {
+	return "FETCH_PARTITION"
 }
 
-func (c *CreateDatabase) String(level int) string {
+func (a *AlterTableFetchPartition) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("CREATE DATABASE ")
-	if c.IfNotExists {
-		builder.WriteString("IF NOT EXISTS ")
-	}
-	builder.WriteString(c.Name.String(level))
-	if c.OnCluster != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(c.OnCluster.String(level))
-	}
-	if c.Engine != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(c.Engine.String(level))
+	builder.WriteString("FETCH ")
+	if a.Part != nil {
+		builder.WriteString("PART ")
+		builder.WriteString(a.Part.String(level))
+	} else {
+		builder.WriteString(a.Partition.String(level))
 	}
+	builder.WriteString(" FROM ")
+	builder.WriteString(a.From.String(level))
 	return builder.String()
 }
 
-func (c *CreateDatabase) Accept(visitor ASTVisitor) error {
-	visitor.enter(c)
-	defer visitor.leave(c)
-	if c.OnCluster != nil {
-		if err := c.OnCluster.Accept(visitor); err != nil {
+func (a *AlterTableFetchPartition) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if a.Partition != nil {
+		if err := a.Partition.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if c.Engine != nil {
-		if err := c.Engine.Accept(visitor); err != nil {
+	if a.Part != nil {
+		if err := a.Part.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitCreateDatabase(c)
+	if err := a.From.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitAlterTableFetchPartition(a)
 }
 
-type CreateTable struct {
-	CreatePos    Pos // position of CREATE|ATTACH keyword
-	StatementEnd Pos
-	Name         *TableIdentifier
-	IfNotExists  bool
-	UUID         *UUID
-	OnCluster    *OnClusterExpr
-	TableSchema  *TableSchemaExpr
-	Engine       *EngineExpr
-	SubQuery     *SubQueryExpr
-	HasTemporary bool
+type AlterTableMaterializeIndex struct {
+	MaterializePos Pos
+	StatementEnd   Pos
+	IfExists       bool
+	IndexName      *NestedIdentifier
+	PartitionExpr  *PartitionExpr
 }
 
-func (c *CreateTable) Pos() Pos {
-	return c.CreatePos
+func (a *AlterTableMaterializeIndex) Pos() Pos {
+	return a.MaterializePos
 }
 
-func (c *CreateTable) End() Pos {
-	return c.StatementEnd
+func (a *AlterTableMaterializeIndex) End() Pos {
+	return a.StatementEnd
 }
 
-func (c *CreateTable) Type() string {
-	return "CREATE TABLE"
+func (a *AlterTableMaterializeIndex) AlterType() string {
+	return "MATERIALIZE_INDEX"
 }
 
-func (c *CreateTable) String(level int) string {
+func (a *AlterTableMaterializeIndex) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("CREATE")
-	if c.HasTemporary {
-		builder.WriteString(" TEMPORARY")
+	builder.WriteString("MATERIALIZE INDEX ")
+	if a.IfExists {
+		builder.WriteString("IF EXISTS ")
 	}
-	builder.WriteString(" TABLE ")
-	if c.IfNotExists {
-		builder.WriteString("IF NOT EXISTS ")
+	builder.WriteString(a.IndexName.String(level))
+	if a.PartitionExpr != nil {
+		builder.WriteString(" IN ")
+		builder.WriteString(a.PartitionExpr.String(level))
 	}
-	builder.WriteString(c.Name.String(level))
-	if c.UUID != nil {
+	return builder.String()
+}
+
+func (a *AlterTableMaterializeIndex) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.IndexName.Accept(visitor); err != nil {
+		return err
+	}
+	if a.PartitionExpr != nil {
+		if err := a.PartitionExpr.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterTableMaterializeIndex(a)
+}
+
+type AlterTableMaterializeColumn struct {
+	MaterializePos Pos
+	StatementEnd   Pos
+	IfExists       bool
+	ColumnName     *NestedIdentifier
+	PartitionExpr  *PartitionExpr
+}
+
+func (a *AlterTableMaterializeColumn) Pos() Pos {
+	return a.MaterializePos
+}
+
+func (a *AlterTableMaterializeColumn) End() Pos {
+	return a.StatementEnd
+}
+
+func (a *AlterTableMaterializeColumn) AlterType() string {
+	return "MATERIALIZE_COLUMN"
+}
+
+func (a *AlterTableMaterializeColumn) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("MATERIALIZE COLUMN ")
+	if a.IfExists {
+		builder.WriteString("IF EXISTS ")
+	}
+	builder.WriteString(a.ColumnName.String(level))
+	if a.PartitionExpr != nil {
+		builder.WriteString(" IN ")
+		builder.WriteString(a.PartitionExpr.String(level))
+	}
+	return builder.String()
+}
+
+func (a *AlterTableMaterializeColumn) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.ColumnName.Accept(visitor); err != nil {
+		return err
+	}
+	if a.PartitionExpr != nil {
+		if err := a.PartitionExpr.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterTableMaterializeColumn(a)
+}
+
+type AlterTableMaterializeTTL struct {
+	MaterializePos Pos
+	StatementEnd   Pos
+	PartitionExpr  *PartitionExpr
+}
+
+func (a *AlterTableMaterializeTTL) Pos() Pos {
+	return a.MaterializePos
+}
+
+func (a *AlterTableMaterializeTTL) End() Pos {
+	return a.StatementEnd
+}
+
+func (a *AlterTableMaterializeTTL) AlterType() string {
+	return "MATERIALIZE_TTL"
+}
+
+func (a *AlterTableMaterializeTTL) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("MATERIALIZE TTL")
+	if a.PartitionExpr != nil {
+		builder.WriteString(" IN ")
+		builder.WriteString(a.PartitionExpr.String(level))
+	}
+	return builder.String()
+}
+
+func (a *AlterTableMaterializeTTL) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if a.PartitionExpr != nil {
+		if err := a.PartitionExpr.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterTableMaterializeTTL(a)
+}
+
+type AlterTableMaterializeProjection struct {
+	MaterializePos Pos
+	StatementEnd   Pos
+	IfExists       bool
+	ProjectionName *NestedIdentifier
+	PartitionExpr  *PartitionExpr
+}
+
+func (a *AlterTableMaterializeProjection) Pos() Pos {
+	return a.MaterializePos
+}
+
+func (a *AlterTableMaterializeProjection) End() Pos {
+	return a.StatementEnd
+}
+
+func (a *AlterTableMaterializeProjection) AlterType() string {
+	return "MATERIALIZE_PROJECTION"
+}
+
+func (a *AlterTableMaterializeProjection) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("MATERIALIZE PROJECTION ")
+	if a.IfExists {
+		builder.WriteString("IF EXISTS ")
+	}
+	builder.WriteString(a.ProjectionName.String(level))
+	if a.PartitionExpr != nil {
+		builder.WriteString(" IN ")
+		builder.WriteString(a.PartitionExpr.String(level))
+	}
+	return builder.String()
+}
+
+func (a *AlterTableMaterializeProjection) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.ProjectionName.Accept(visitor); err != nil {
+		return err
+	}
+	if a.PartitionExpr != nil {
+		if err := a.PartitionExpr.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterTableMaterializeProjection(a)
+}
+
+type AlterTableAddProjection struct {
+	AddPos          Pos
+	StatementEnd    Pos
+	IfNotExists     bool
+	TableProjection *TableProjection
+	After           *NestedIdentifier
+}
+
+func (a *AlterTableAddProjection) Pos() Pos {
+	return a.AddPos
+}
+
+func (a *AlterTableAddProjection) End() Pos {
+	return a.StatementEnd
+}
+
+func (a *AlterTableAddProjection) AlterType() string {
+	return "ADD_PROJECTION"
+}
+
+func (a *AlterTableAddProjection) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("ADD PROJECTION ")
+	if a.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	builder.WriteString(a.TableProjection.String(level))
+	if a.After != nil {
+		builder.WriteString(" AFTER ")
+		builder.WriteString(a.After.String(level))
+	}
+	return builder.String()
+}
+
+func (a *AlterTableAddProjection) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.TableProjection.Accept(visitor); err != nil {
+		return err
+	}
+	if a.After != nil {
+		if err := a.After.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterTableAddProjection(a)
+}
+
+type AlterTableDropProjection struct {
+	DropPos        Pos
+	IfExists       bool
+	ProjectionName *NestedIdentifier
+}
+
+func (a *AlterTableDropProjection) Pos() Pos {
+	return a.DropPos
+}
+
+func (a *AlterTableDropProjection) End() Pos {
+	return a.ProjectionName.End()
+}
+
+func (a *AlterTableDropProjection) AlterType() string {
+	return "DROP_PROJECTION"
+}
+
+func (a *AlterTableDropProjection) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("DROP PROJECTION ")
+	if a.IfExists {
+		builder.WriteString("IF EXISTS ")
+	}
+	builder.WriteString(a.ProjectionName.String(level))
+	return builder.String()
+}
+
+func (a *AlterTableDropProjection) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.ProjectionName.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitAlterTableDropProjection(a)
+}
+
+type AlterTableClearProjection struct {
+	ClearPos       Pos
+	StatementEnd   Pos
+	IfExists       bool
+	ProjectionName *NestedIdentifier
+	PartitionExpr  *PartitionExpr
+}
+
+func (a *AlterTableClearProjection) Pos() Pos {
+	return a.ClearPos
+}
+
+func (a *AlterTableClearProjection) End() Pos {
+	return a.StatementEnd
+}
+
+func (a *AlterTableClearProjection) AlterType() string {
+	return "CLEAR_PROJECTION"
+}
+
+func (a *AlterTableClearProjection) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("CLEAR PROJECTION ")
+	if a.IfExists {
+		builder.WriteString("IF EXISTS ")
+	}
+	builder.WriteString(a.ProjectionName.String(level))
+	if a.PartitionExpr != nil {
+		builder.WriteString(" IN ")
+		builder.WriteString(a.PartitionExpr.String(level))
+	}
+	return builder.String()
+}
+
+func (a *AlterTableClearProjection) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.ProjectionName.Accept(visitor); err != nil {
+		return err
+	}
+	if a.PartitionExpr != nil {
+		if err := a.PartitionExpr.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterTableClearProjection(a)
+}
+
+type AlterTableAddConstraint struct {
+	AddPos      Pos
+	IfNotExists bool
+	Constraint  *ConstraintExpr
+}
+
+func (a *AlterTableAddConstraint) Pos() Pos {
+	return a.AddPos
+}
+
+func (a *AlterTableAddConstraint) End() Pos {
+	return a.Constraint.End()
+}
+
+func (a *AlterTableAddConstraint) AlterType() string {
+	return "ADD_CONSTRAINT"
+}
+
+func (a *AlterTableAddConstraint) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("ADD CONSTRAINT ")
+	if a.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	builder.WriteString(a.Constraint.String(level))
+	return builder.String()
+}
+
+func (a *AlterTableAddConstraint) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.Constraint.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitAlterTableAddConstraint(a)
+}
+
+type AlterTableDropConstraint struct {
+	DropPos        Pos
+	IfExists       bool
+	ConstraintName *Ident
+}
+
+func (a *AlterTableDropConstraint) Pos() Pos {
+	return a.DropPos
+}
+
+func (a *AlterTableDropConstraint) End() Pos {
+	return a.ConstraintName.End()
+}
+
+func (a *AlterTableDropConstraint) AlterType() string {
+	return "DROP_CONSTRAINT"
+}
+
+func (a *AlterTableDropConstraint) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("DROP CONSTRAINT ")
+	if a.IfExists {
+		builder.WriteString("IF EXISTS ")
+	}
+	builder.WriteString(a.ConstraintName.String(level))
+	return builder.String()
+}
+
+func (a *AlterTableDropConstraint) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.ConstraintName.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitAlterTableDropConstraint(a)
+}
+
+type AlterTableModifyOrderBy struct {
+	ModifyPos Pos
+	OrderBy   *OrderByListExpr
+}
+
+func (a *AlterTableModifyOrderBy) Pos() Pos {
+	return a.ModifyPos
+}
+
+func (a *AlterTableModifyOrderBy) End() Pos {
+	return a.OrderBy.End()
+}
+
+func (a *AlterTableModifyOrderBy) AlterType() string {
+	return "MODIFY_ORDER_BY"
+}
+
+func (a *AlterTableModifyOrderBy) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("MODIFY ")
+	builder.WriteString(a.OrderBy.String(level))
+	return builder.String()
+}
+
+func (a *AlterTableModifyOrderBy) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.OrderBy.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitAlterTableModifyOrderBy(a)
+}
+
+type AlterTableModifySetting struct {
+	ModifyPos    Pos
+	StatementEnd Pos
+	Settings     []*SettingsExpr
+}
+
+func (a *AlterTableModifySetting) Pos() Pos {
+	return a.ModifyPos
+}
+
+func (a *AlterTableModifySetting) End() Pos {
+	return a.StatementEnd
+}
+
+func (a *AlterTableModifySetting) AlterType() string {
+	return "MODIFY_SETTING"
+}
+
+func (a *AlterTableModifySetting) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("MODIFY SETTING ")
+	for i, setting := range a.Settings {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(setting.String(level))
+	}
+	return builder.String()
+}
+
+func (a *AlterTableModifySetting) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	for _, setting := range a.Settings {
+		if err := setting.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterTableModifySetting(a)
+}
+
+type AlterTableResetSetting struct {
+	ResetPos     Pos
+	StatementEnd Pos
+	Settings     []*Ident
+}
+
+func (a *AlterTableResetSetting) Pos() Pos {
+	return a.ResetPos
+}
+
+func (a *AlterTableResetSetting) End() Pos {
+	return a.StatementEnd
+}
+
+func (a *AlterTableResetSetting) AlterType() string {
+	return "RESET_SETTING"
+}
+
+func (a *AlterTableResetSetting) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("RESET SETTING ")
+	for i, setting := range a.Settings {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(setting.String(level))
+	}
+	return builder.String()
+}
+
+func (a *AlterTableResetSetting) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	for _, setting := range a.Settings {
+		if err := setting.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterTableResetSetting(a)
+}
+
+type AlterTableModifyComment struct {
+	ModifyPos Pos
+	Comment   *StringLiteral
+}
+
+func (a *AlterTableModifyComment) Pos() Pos {
+	return a.ModifyPos
+}
+
+func (a *AlterTableModifyComment) End() Pos {
+	return a.Comment.End()
+}
+
+func (a *AlterTableModifyComment) AlterType() string {
+	return "MODIFY_COMMENT"
+}
+
+func (a *AlterTableModifyComment) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("MODIFY COMMENT ")
+	builder.WriteString(a.Comment.String(level))
+	return builder.String()
+}
+
+func (a *AlterTableModifyComment) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.Comment.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitAlterTableModifyComment(a)
+}
+
+type AlterTableModifyQuery struct {
+	ModifyPos  Pos
+	SelectExpr Expr
+}
+
+func (a *AlterTableModifyQuery) Pos() Pos {
+	return a.ModifyPos
+}
+
+func (a *AlterTableModifyQuery) End() Pos {
+	return a.SelectExpr.End()
+}
+
+func (a *AlterTableModifyQuery) AlterType() string {
+	return "MODIFY_QUERY"
+}
+
+func (a *AlterTableModifyQuery) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("MODIFY QUERY")
+	builder.WriteString(a.SelectExpr.String(level))
+	return builder.String()
+}
+
+func (a *AlterTableModifyQuery) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.SelectExpr.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitAlterTableModifyQuery(a)
+}
+
+type AlterTableCommentColumn struct {
+	CommentPos Pos
+	IfExists   bool
+	ColumnName *NestedIdentifier
+	Comment    *StringLiteral
+}
+
+func (a *AlterTableCommentColumn) Pos() Pos {
+	return a.CommentPos
+}
+
+func (a *AlterTableCommentColumn) End() Pos {
+	return a.Comment.End()
+}
+
+func (a *AlterTableCommentColumn) AlterType() string {
+	return "COMMENT_COLUMN"
+}
+
+func (a *AlterTableCommentColumn) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("COMMENT COLUMN ")
+	if a.IfExists {
+		builder.WriteString("IF EXISTS ")
+	}
+	builder.WriteString(a.ColumnName.String(level))
+	builder.WriteByte(' ')
+	builder.WriteString(a.Comment.String(level))
+	return builder.String()
+}
+
+func (a *AlterTableCommentColumn) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.ColumnName.Accept(visitor); err != nil {
+		return err
+	}
+	if err := a.Comment.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitAlterTableCommentColumn(a)
+}
+
+type AlterTableUnfreeze struct {
+	UnfreezePos Pos
+	Partition   *PartitionExpr
+	Name        *StringLiteral
+}
+
+func (a *AlterTableUnfreeze) Pos() Pos {
+	return a.UnfreezePos
+}
+
+func (a *AlterTableUnfreeze) End() Pos {
+	return a.Name.End()
+}
+
+func (a *AlterTableUnfreeze) AlterType() string {
+	return "UNFREEZE"
+}
+
+func (a *AlterTableUnfreeze) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("UNFREEZE ")
+	if a.Partition != nil {
+		builder.WriteString(a.Partition.String(level))
+		builder.WriteByte(' ')
+	}
+	builder.WriteString("WITH NAME ")
+	builder.WriteString(a.Name.String(level))
+	return builder.String()
+}
+
+func (a *AlterTableUnfreeze) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if a.Partition != nil {
+		if err := a.Partition.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if err := a.Name.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitAlterTableUnfreeze(a)
+}
+
+type TableProjection struct {
+	ProjectionPos Pos
+	Identifier    *NestedIdentifier
+	Select        *ProjectionSelect
+	Settings      *SettingsExprList // WITH SETTINGS (...)
+	SettingsEnd   Pos
+}
+
+func (t *TableProjection) Pos() Pos {
+	return t.ProjectionPos
+}
+
+func (t *TableProjection) End() Pos {
+	if t.Settings != nil {
+		return t.SettingsEnd
+	}
+	return t.Select.End()
+}
+
+func (t *TableProjection) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(t.Identifier.String(level))
+	builder.WriteByte(' ')
+	builder.WriteString(t.Select.String(level))
+	if t.Settings != nil {
+		builder.WriteString(" WITH SETTINGS (")
+		for i, item := range t.Settings.Items {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(item.String(level))
+		}
+		builder.WriteByte(')')
+	}
+	return builder.String()
+}
+
+func (t *TableProjection) Accept(visitor ASTVisitor) error {
+	visitor.enter(t)
+	defer visitor.leave(t)
+	if err := t.Identifier.Accept(visitor); err != nil {
+		return err
+	}
+	if err := t.Select.Accept(visitor); err != nil {
+		return err
+	}
+	if t.Settings != nil {
+		if err := t.Settings.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitTableProjection(t)
+}
+
+type ProjectionSelect struct {
+	LeftParenPos  Pos
+	RightParenPos Pos
+	SelectColumns *ColumnExprList
+	GroupBy       *GroupByExpr
+	OrderBy       *OrderByListExpr
+}
+
+func (p *ProjectionSelect) Pos() Pos {
+	return p.LeftParenPos
+}
+
+func (p *ProjectionSelect) End() Pos {
+	return p.RightParenPos
+}
+
+func (p *ProjectionSelect) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("(SELECT ")
+	builder.WriteString(p.SelectColumns.String(level))
+	if p.GroupBy != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(p.GroupBy.String(level))
+	}
+	if p.OrderBy != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(p.OrderBy.String(level))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+func (p *ProjectionSelect) Accept(visitor ASTVisitor) error {
+	visitor.enter(p)
+	defer visitor.leave(p)
+	if err := p.SelectColumns.Accept(visitor); err != nil {
+		return err
+	}
+	if p.GroupBy != nil {
+		if err := p.GroupBy.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if p.OrderBy != nil {
+		if err := p.OrderBy.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitProjectionSelect(p)
+}
+
+type RemovePropertyType struct {
+	RemovePos Pos
+
+	PropertyType Expr
+}
+
+func (a *RemovePropertyType) Pos() Pos {
+	return a.RemovePos
+}
+
+func (a *RemovePropertyType) End() Pos {
+	return a.PropertyType.End()
+}
+
+func (a *RemovePropertyType) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(" REMOVE ")
+	builder.WriteString(a.PropertyType.String(level))
+	return builder.String()
+}
+
+func (a *RemovePropertyType) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.PropertyType.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitRemovePropertyType(a)
+}
+
+type TableIndex struct {
+	IndexPos Pos
+
+	Name        *NestedIdentifier
+	ColumnExpr  Expr
+	ColumnType  Expr           // index type like minmax or bloom_filter(0.01)
+	Granularity *NumberLiteral // optional, defaults to 1
+}
+
+func (a *TableIndex) Pos() Pos {
+	return a.IndexPos
+}
+
+func (a *TableIndex) End() Pos {
+	if a.Granularity != nil {
+		return a.Granularity.End()
+	}
+	return a.ColumnType.End()
+}
+
+func (a *TableIndex) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(a.Name.String(0))
+	builder.WriteByte(' ')
+	builder.WriteString(a.ColumnExpr.String(level))
+	builder.WriteString(" TYPE ")
+	builder.WriteString(a.ColumnType.String(level))
+	if a.Granularity != nil {
+		builder.WriteString(" GRANULARITY ")
+		builder.WriteString(a.Granularity.String(level))
+	}
+	return builder.String()
+}
+
+func (a *TableIndex) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if err := a.ColumnExpr.Accept(visitor); err != nil {
+		return err
+	}
+	if err := a.ColumnType.Accept(visitor); err != nil {
+		return err
+	}
+	if a.Granularity != nil {
+		if err := a.Granularity.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitTableIndex(a)
+}
+
+type CreateIndex struct {
+	CreatePos    Pos
+	StatementEnd Pos
+
+	IfNotExists bool
+	Table       *TableIdentifier
+	Index       *TableIndex
+}
+
+func (c *CreateIndex) Pos() Pos {
+	return c.CreatePos
+}
+
+func (c *CreateIndex) End() Pos {
+	return c.StatementEnd
+}
+
+func (c *CreateIndex) Type() string {
+	return "INDEX"
+}
+
+func (c *CreateIndex) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("CREATE INDEX ")
+	if c.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	builder.WriteString(c.Index.Name.String(level))
+	builder.WriteString(" ON ")
+	builder.WriteString(c.Table.String(level))
+	builder.WriteByte(' ')
+	builder.WriteString(c.Index.ColumnExpr.String(level))
+	builder.WriteString(" TYPE ")
+	builder.WriteString(c.Index.ColumnType.String(level))
+	if c.Index.Granularity != nil {
+		builder.WriteString(" GRANULARITY ")
+		builder.WriteString(c.Index.Granularity.String(level))
+	}
+	return builder.String()
+}
+
+func (c *CreateIndex) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if err := c.Table.Accept(visitor); err != nil {
+		return err
+	}
+	if err := c.Index.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitCreateIndex(c)
+}
+
+type DropIndex struct {
+	DropPos      Pos
+	StatementEnd Pos
+
+	IfExists bool
+	Name     *NestedIdentifier
+	Table    *TableIdentifier
+}
+
+func (d *DropIndex) Pos() Pos {
+	return d.DropPos
+}
+
+func (d *DropIndex) End() Pos {
+	return d.StatementEnd
+}
+
+func (d *DropIndex) Type() string {
+	return "INDEX"
+}
+
+func (d *DropIndex) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("DROP INDEX ")
+	if d.IfExists {
+		builder.WriteString("IF EXISTS ")
+	}
+	builder.WriteString(d.Name.String(level))
+	builder.WriteString(" ON ")
+	builder.WriteString(d.Table.String(level))
+	return builder.String()
+}
+
+func (d *DropIndex) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	if err := d.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if err := d.Table.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitDropIndex(d)
+}
+
+type Ident struct {
+	Name      string
+	QuoteType int
+	NamePos   Pos
+	NameEnd   Pos
+}
+
+func (i *Ident) Pos() Pos {
+	return i.NamePos
+}
+
+func (i *Ident) End() Pos {
+	return i.NameEnd
+}
+
+func (i *Ident) String(int) string {
+	if i.QuoteType == BackTicks {
+		return "`" + i.Name + "`"
+	} else if i.QuoteType == DoubleQuote {
+		return `"` + i.Name + `"`
+	}
+	return i.Name
+}
+
+func (i *Ident) Accept(visitor ASTVisitor) error {
+	visitor.enter(i)
+	defer visitor.leave(i)
+	return visitor.VisitIdent(i)
+}
+
+type UUID struct {
+	Value *StringLiteral
+}
+
+func (u *UUID) Pos() Pos {
+	return u.Value.LiteralPos
+}
+
+func (u *UUID) End() Pos {
+	return u.Value.LiteralEnd
+}
+
+func (u *UUID) String(level int) string {
+	return "UUID " + u.Value.String(level)
+}
+
+func (u *UUID) Accept(visitor ASTVisitor) error {
+	visitor.enter(u)
+	defer visitor.leave(u)
+	return visitor.VisitUUID(u)
+}
+
+type CreateDatabase struct {
+	CreatePos    Pos // position of CREATE keyword
+	StatementEnd Pos
+	Name         Expr
+	IfNotExists  bool // true if 'IF NOT EXISTS' is specified
+	OnCluster    *OnClusterExpr
+	Engine       *EngineExpr
+}
+
+func (c *CreateDatabase) Pos() Pos {
+	return c.CreatePos
+}
+
+func (c *CreateDatabase) End() Pos {
+	return c.StatementEnd
+}
+
+func (c *CreateDatabase) Type() string {
+	return "DATABASE"
+}
+
+func (c *CreateDatabase) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("CREATE DATABASE ")
+	if c.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	builder.WriteString(c.Name.String(level))
+	if c.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.OnCluster.String(level))
+	}
+	if c.Engine != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.Engine.String(level))
+	}
+	return builder.String()
+}
+
+func (c *CreateDatabase) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if c.OnCluster != nil {
+		if err := c.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Engine != nil {
+		if err := c.Engine.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitCreateDatabase(c)
+}
+
+type CreateTable struct {
+	CreatePos    Pos // position of CREATE|ATTACH keyword
+	StatementEnd Pos
+	Name         *TableIdentifier
+	IfNotExists  bool
+	UUID         *UUID
+	OnCluster    *OnClusterExpr
+	TableSchema  *TableSchemaExpr
+	Engine       *EngineExpr
+	Comment      *StringLiteral
+	Empty        bool // EMPTY AS SELECT creates the table without filling the data
+	SubQuery     *SubQueryExpr
+	HasTemporary bool
+}
+
+func (c *CreateTable) Pos() Pos {
+	return c.CreatePos
+}
+
+func (c *CreateTable) End() Pos {
+	return c.StatementEnd
+}
+
+func (c *CreateTable) Type() string {
+	return "CREATE TABLE"
+}
+
+func (c *CreateTable) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("CREATE")
+	if c.HasTemporary {
+		builder.WriteString(" TEMPORARY")
+	}
+	builder.WriteString(" TABLE ")
+	if c.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	builder.WriteString(c.Name.String(level))
+	if c.UUID != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.UUID.String(level))
+	}
+	if c.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.OnCluster.String(level))
+	}
+	if c.TableSchema != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.TableSchema.String(level))
+	}
+	if c.Engine != nil {
+		builder.WriteString(c.Engine.String(level))
+	}
+	if c.Comment != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString("COMMENT ")
+		builder.WriteString(c.Comment.String(level))
+	}
+	if c.Empty {
+		builder.WriteString(NewLine(level))
+		builder.WriteString("EMPTY")
+	}
+	if c.SubQuery != nil {
+		builder.WriteString(c.SubQuery.String(level))
+	}
+	return builder.String()
+}
+
+func (c *CreateTable) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if c.UUID != nil {
+		if err := c.UUID.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.OnCluster != nil {
+		if err := c.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.TableSchema != nil {
+		if err := c.TableSchema.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Engine != nil {
+		if err := c.Engine.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Comment != nil {
+		if err := c.Comment.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.SubQuery != nil {
+		if err := c.SubQuery.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitCreateTable(c)
+}
+
+type CreateMaterializedView struct {
+	CreatePos    Pos // position of CREATE|ATTACH keyword
+	StatementEnd Pos
+	Name         *TableIdentifier
+	IfNotExists  bool
+	OnCluster    *OnClusterExpr
+	Refresh      *RefreshExpr
+	Append       bool
+	Engine       *EngineExpr
+	Destination  *DestinationExpr
+	SubQuery     *SubQueryExpr
+	Empty        bool
+	Populate     bool
+}
+
+func (c *CreateMaterializedView) Pos() Pos {
+	return c.CreatePos
+}
+
+func (c *CreateMaterializedView) End() Pos {
+	return c.StatementEnd
+}
+
+func (c *CreateMaterializedView) Type() string {
+	return "MATERIALIZED_VIEW"
+}
+
+func (c *CreateMaterializedView) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("CREATE MATERIALIZED VIEW ")
+	if c.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	builder.WriteString(c.Name.String(level))
+	if c.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.OnCluster.String(level))
+	}
+	if c.Refresh != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.Refresh.String(level))
+	}
+	if c.Append {
+		builder.WriteString(NewLine(level))
+		builder.WriteString("APPEND")
+	}
+	if c.Engine != nil {
+		builder.WriteString(c.Engine.String(level))
+	}
+	if c.Destination != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.Destination.String(level))
+		if c.Destination.TableSchema != nil {
+			builder.WriteString(NewLine(level))
+			// level + 1 to add an indent for table schema
+			builder.WriteString(c.Destination.TableSchema.String(level + 1))
+		}
+	}
+	if c.Empty {
+		builder.WriteString(" EMPTY")
+	}
+	if c.Populate {
+		builder.WriteString(" POPULATE ")
+	}
+	if c.SubQuery != nil {
+		builder.WriteString(c.SubQuery.String(level))
+	}
+	return builder.String()
+}
+
+func (c *CreateMaterializedView) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if err := c.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if c.OnCluster != nil {
+		if err := c.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Refresh != nil {
+		if err := c.Refresh.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Engine != nil {
+		if err := c.Engine.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Destination != nil {
+		if err := c.Destination.Accept(visitor); err != nil {
+			return err
+		}
+		if c.Destination.TableSchema != nil {
+			if err := c.Destination.TableSchema.Accept(visitor); err != nil {
+				return err
+			}
+		}
+	}
+	if c.SubQuery != nil {
+		if err := c.SubQuery.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitCreateMaterializedView(c)
+}
+
+type RefreshExpr struct {
+	RefreshPos   Pos
+	RefreshEnd   Pos
+	Frequency    string // EVERY or AFTER
+	Interval     *IntervalExpr
+	Offset       *IntervalExpr
+	RandomizeFor *IntervalExpr
+	DependsOn    []*TableIdentifier
+	Settings     *SettingsExprList
+}
+
+func (r *RefreshExpr) Pos() Pos {
+	return r.RefreshPos
+}
+
+func (r *RefreshExpr) End() Pos {
+	return r.RefreshEnd
+}
+
+// refreshIntervalString formats the interval without the INTERVAL keyword, e.g. 1 HOUR.
+func refreshIntervalString(interval *IntervalExpr, level int) string {
+	return interval.Expr.String(level) + " " + interval.Unit.String(level)
+}
+
+func (r *RefreshExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("REFRESH ")
+	builder.WriteString(r.Frequency)
+	builder.WriteByte(' ')
+	builder.WriteString(refreshIntervalString(r.Interval, level))
+	if r.Offset != nil {
+		builder.WriteString(" OFFSET ")
+		builder.WriteString(refreshIntervalString(r.Offset, level))
+	}
+	if r.RandomizeFor != nil {
+		builder.WriteString(" RANDOMIZE FOR ")
+		builder.WriteString(refreshIntervalString(r.RandomizeFor, level))
+	}
+	if len(r.DependsOn) > 0 {
+		builder.WriteString(" DEPENDS ON ")
+		for i, table := range r.DependsOn {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(table.String(level))
+		}
+	}
+	if r.Settings != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(r.Settings.String(level))
+	}
+	return builder.String()
+}
+
+func (r *RefreshExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(r)
+	defer visitor.leave(r)
+	if err := r.Interval.Accept(visitor); err != nil {
+		return err
+	}
+	if r.Offset != nil {
+		if err := r.Offset.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if r.RandomizeFor != nil {
+		if err := r.RandomizeFor.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, table := range r.DependsOn {
+		if err := table.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if r.Settings != nil {
+		if err := r.Settings.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitRefreshExpr(r)
+}
+
+type CreateView struct {
+	CreatePos    Pos // position of CREATE|ATTACH keyword
+	StatementEnd Pos
+	Name         *TableIdentifier
+	IfNotExists  bool
+	UUID         *UUID
+	OnCluster    *OnClusterExpr
+	TableSchema  *TableSchemaExpr
+	SubQuery     *SubQueryExpr
+}
+
+func (c *CreateView) Pos() Pos {
+	return c.CreatePos
+}
+
+func (c *CreateView) End() Pos {
+	return c.StatementEnd
+}
+
+func (c *CreateView) Type() string {
+	return "VIEW"
+}
+
+func (c *CreateView) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("CREATE VIEW ")
+	if c.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	builder.WriteString(c.Name.String(level))
+	if c.UUID != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.UUID.String(level))
+	}
+
+	if c.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.OnCluster.String(level))
+	}
+
+	if c.TableSchema != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.TableSchema.String(level))
+	}
+
+	if c.SubQuery != nil {
+		builder.WriteString(c.SubQuery.String(level))
+	}
+	return builder.String()
+}
+
+func (c *CreateView) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if err := c.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if c.UUID != nil {
+		if err := c.UUID.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.OnCluster != nil {
+		if err := c.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.TableSchema != nil {
+		if err := c.TableSchema.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.SubQuery != nil {
+		if err := c.SubQuery.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitCreateView(c)
+}
+
+type CreateFunction struct {
+	CreatePos    Pos
+	IfNotExists  bool
+	FunctionName *Ident
+	OnCluster    *OnClusterExpr
+	Params       *ParamExprList
+	Expr         Expr
+}
+
+func (c *CreateFunction) Type() string {
+	return "FUNCTION"
+}
+
+func (c *CreateFunction) Pos() Pos {
+	return c.CreatePos
+}
+
+func (c *CreateFunction) End() Pos {
+	return c.Expr.End()
+}
+
+func (c *CreateFunction) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("CREATE FUNCTION ")
+	if c.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	builder.WriteString(c.FunctionName.String(level))
+	if c.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.OnCluster.String(level))
+	}
+	builder.WriteString(" AS ")
+	builder.WriteString(c.Params.String(level))
+	builder.WriteString(" -> ")
+	builder.WriteString(c.Expr.String(level))
+	return builder.String()
+}
+
+func (c *CreateFunction) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if err := c.FunctionName.Accept(visitor); err != nil {
+		return err
+	}
+	if c.OnCluster != nil {
+		if err := c.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if err := c.Params.Accept(visitor); err != nil {
+		return err
+	}
+	if err := c.Expr.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitCreateFunction(c)
+}
+
+type CreateDictionary struct {
+	CreatePos    Pos // position of CREATE|ATTACH keyword
+	StatementEnd Pos
+	Name         *TableIdentifier
+	OrReplace    bool
+	IfNotExists  bool
+	UUID         *UUID
+	OnCluster    *OnClusterExpr
+	Schema       *DictionarySchemaExpr
+	PrimaryKey   *PrimaryKeyExpr
+	Source       *DictionarySourceExpr
+	Layout       *DictionaryLayoutExpr
+	Lifetime     *DictionaryLifetimeExpr
+	Range        *DictionaryRangeExpr
+	Settings     *SettingsExprList
+	Comment      *StringLiteral
+}
+
+func (c *CreateDictionary) Pos() Pos {
+	return c.CreatePos
+}
+
+func (c *CreateDictionary) End() Pos {
+	return c.StatementEnd
+}
+
+func (c *CreateDictionary) Type() string {
+	return "DICTIONARY"
+}
+
+func (c *CreateDictionary) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("CREATE ")
+	if c.OrReplace {
+		builder.WriteString("OR REPLACE ")
+	}
+	builder.WriteString("DICTIONARY ")
+	if c.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	builder.WriteString(c.Name.String(level))
+	if c.UUID != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.UUID.String(level))
+	}
+	if c.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.OnCluster.String(level))
+	}
+	builder.WriteString(NewLine(level))
+	builder.WriteString(c.Schema.String(level))
+	if c.PrimaryKey != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.PrimaryKey.String(level))
+	}
+	if c.Source != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.Source.String(level))
+	}
+	if c.Layout != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.Layout.String(level))
+	}
+	if c.Lifetime != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.Lifetime.String(level))
+	}
+	if c.Range != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.Range.String(level))
+	}
+	if c.Settings != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString("SETTINGS(")
+		for i, item := range c.Settings.Items {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(item.String(level))
+		}
+		builder.WriteByte(')')
+	}
+	if c.Comment != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString("COMMENT ")
+		builder.WriteString(c.Comment.String(level))
+	}
+	return builder.String()
+}
+
+func (c *CreateDictionary) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if err := c.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if c.UUID != nil {
+		if err := c.UUID.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.OnCluster != nil {
+		if err := c.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if err := c.Schema.Accept(visitor); err != nil {
+		return err
+	}
+	if c.PrimaryKey != nil {
+		if err := c.PrimaryKey.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Source != nil {
+		if err := c.Source.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Layout != nil {
+		if err := c.Layout.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Lifetime != nil {
+		if err := c.Lifetime.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Range != nil {
+		if err := c.Range.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Settings != nil {
+		if err := c.Settings.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Comment != nil {
+		if err := c.Comment.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitCreateDictionary(c)
+}
+
+type DictionarySchemaExpr struct {
+	SchemaPos  Pos
+	SchemaEnd  Pos
+	Attributes []*DictionaryAttribute
+}
+
+func (d *DictionarySchemaExpr) Pos() Pos {
+	return d.SchemaPos
+}
+
+func (d *DictionarySchemaExpr) End() Pos {
+	return d.SchemaEnd
+}
+
+func (d *DictionarySchemaExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("(")
+	for i, attribute := range d.Attributes {
+		if i > 0 {
+			builder.WriteByte(',')
+		}
+		builder.WriteString(NewLine(level + 1))
+		builder.WriteString(attribute.String(level))
+	}
+	builder.WriteString(NewLine(level))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+func (d *DictionarySchemaExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	for _, attribute := range d.Attributes {
+		if err := attribute.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitDictionarySchemaExpr(d)
+}
+
+type DictionaryAttribute struct {
+	NamePos      Pos
+	AttributeEnd Pos
+	Name         *Ident
+	Type         Expr
+	Default      Expr
+	Expression   Expr
+	Hierarchical bool
+	Injective    bool
+	IsObjectID   bool
+}
+
+func (d *DictionaryAttribute) Pos() Pos {
+	return d.NamePos
+}
+
+func (d *DictionaryAttribute) End() Pos {
+	return d.AttributeEnd
+}
+
+func (d *DictionaryAttribute) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(d.Name.String(level))
+	builder.WriteByte(' ')
+	builder.WriteString(d.Type.String(level))
+	if d.Default != nil {
+		builder.WriteString(" DEFAULT ")
+		builder.WriteString(d.Default.String(level))
+	}
+	if d.Expression != nil {
+		builder.WriteString(" EXPRESSION ")
+		builder.WriteString(d.Expression.String(level))
+	}
+	if d.Hierarchical {
+		builder.WriteString(" HIERARCHICAL")
+	}
+	if d.Injective {
+		builder.WriteString(" INJECTIVE")
+	}
+	if d.IsObjectID {
+		builder.WriteString(" IS_OBJECT_ID")
+	}
+	return builder.String()
+}
+
+func (d *DictionaryAttribute) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	if err := d.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if err := d.Type.Accept(visitor); err != nil {
+		return err
+	}
+	if d.Default != nil {
+		if err := d.Default.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if d.Expression != nil {
+		if err := d.Expression.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitDictionaryAttribute(d)
+}
+
+type DictionaryArgExpr struct {
+	Name  *Ident
+	Value Expr
+}
+
+func (d *DictionaryArgExpr) Pos() Pos {
+	return d.Name.NamePos
+}
+
+func (d *DictionaryArgExpr) End() Pos {
+	return d.Value.End()
+}
+
+func (d *DictionaryArgExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(d.Name.String(level))
+	if _, ok := d.Value.(*DictionaryArgListExpr); !ok {
+		builder.WriteByte(' ')
+	}
+	builder.WriteString(d.Value.String(level))
+	return builder.String()
+}
+
+func (d *DictionaryArgExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	if err := d.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if err := d.Value.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitDictionaryArgExpr(d)
+}
+
+// DictionaryArgListExpr is the nested argument list of a SOURCE argument, like headers(header(name 'x' value 'y')).
+type DictionaryArgListExpr struct {
+	LParenPos Pos
+	RParenPos Pos
+	Args      []*DictionaryArgExpr
+}
+
+func (d *DictionaryArgListExpr) Pos() Pos {
+	return d.LParenPos
+}
+
+func (d *DictionaryArgListExpr) End() Pos {
+	return d.RParenPos
+}
+
+func (d *DictionaryArgListExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteByte('(')
+	for i, arg := range d.Args {
+		if i > 0 {
+			builder.WriteByte(' ')
+		}
+		builder.WriteString(arg.String(level))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+func (d *DictionaryArgListExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	for _, arg := range d.Args {
+		if err := arg.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitDictionaryArgListExpr(d)
+}
+
+type DictionarySourceExpr struct {
+	SourcePos Pos
+	RParenPos Pos
+	Source    *Ident
+	Args      []*DictionaryArgExpr
+}
+
+func (d *DictionarySourceExpr) Pos() Pos {
+	return d.SourcePos
+}
+
+func (d *DictionarySourceExpr) End() Pos {
+	return d.RParenPos
+}
+
+func (d *DictionarySourceExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("SOURCE(")
+	builder.WriteString(d.Source.String(level))
+	builder.WriteByte('(')
+	for i, arg := range d.Args {
+		if i > 0 {
+			builder.WriteByte(' ')
+		}
+		builder.WriteString(arg.String(level))
+	}
+	builder.WriteString("))")
+	return builder.String()
+}
+
+func (d *DictionarySourceExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	if err := d.Source.Accept(visitor); err != nil {
+		return err
+	}
+	for _, arg := range d.Args {
+		if err := arg.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitDictionarySourceExpr(d)
+}
+
+type DictionaryLayoutExpr struct {
+	LayoutPos Pos
+	RParenPos Pos
+	Layout    *Ident
+	Args      []*DictionaryArgExpr
+}
+
+func (d *DictionaryLayoutExpr) Pos() Pos {
+	return d.LayoutPos
+}
+
+func (d *DictionaryLayoutExpr) End() Pos {
+	return d.RParenPos
+}
+
+func (d *DictionaryLayoutExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("LAYOUT(")
+	builder.WriteString(d.Layout.String(level))
+	builder.WriteByte('(')
+	for i, arg := range d.Args {
+		if i > 0 {
+			builder.WriteByte(' ')
+		}
+		builder.WriteString(arg.String(level))
+	}
+	builder.WriteString("))")
+	return builder.String()
+}
+
+func (d *DictionaryLayoutExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	if err := d.Layout.Accept(visitor); err != nil {
+		return err
+	}
+	for _, arg := range d.Args {
+		if err := arg.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitDictionaryLayoutExpr(d)
+}
+
+type DictionaryLifetimeExpr struct {
+	LifetimePos Pos
+	RParenPos   Pos
+	Min         *NumberLiteral
+	Max         *NumberLiteral
+	Value       *NumberLiteral
+}
+
+func (d *DictionaryLifetimeExpr) Pos() Pos {
+	return d.LifetimePos
+}
+
+func (d *DictionaryLifetimeExpr) End() Pos {
+	return d.RParenPos
+}
+
+func (d *DictionaryLifetimeExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("LIFETIME(")
+	if d.Value != nil {
+		builder.WriteString(d.Value.String(level))
+	} else {
+		builder.WriteString("MIN ")
+		builder.WriteString(d.Min.String(level))
+		builder.WriteString(" MAX ")
+		builder.WriteString(d.Max.String(level))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+func (d *DictionaryLifetimeExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	if d.Value != nil {
+		if err := d.Value.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if d.Min != nil {
+		if err := d.Min.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if d.Max != nil {
+		if err := d.Max.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitDictionaryLifetimeExpr(d)
+}
+
+type DictionaryRangeExpr struct {
+	RangePos  Pos
+	RParenPos Pos
+	Min       *Ident
+	Max       *Ident
+}
+
+func (d *DictionaryRangeExpr) Pos() Pos {
+	return d.RangePos
+}
+
+func (d *DictionaryRangeExpr) End() Pos {
+	return d.RParenPos
+}
+
+func (d *DictionaryRangeExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("RANGE(MIN ")
+	builder.WriteString(d.Min.String(level))
+	builder.WriteString(" MAX ")
+	builder.WriteString(d.Max.String(level))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+func (d *DictionaryRangeExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	if err := d.Min.Accept(visitor); err != nil {
+		return err
+	}
+	if err := d.Max.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitDictionaryRangeExpr(d)
+}
+
+type RoleName struct {
+	Name      Expr
+	Scope     *StringLiteral
+	OnCluster *OnClusterExpr
+}
+
+func (r *RoleName) Pos() Pos {
+	return r.Name.Pos()
+}
+
+func (r *RoleName) End() Pos {
+	if r.OnCluster != nil {
+		return r.OnCluster.End()
+	}
+	if r.Scope != nil {
+		return r.Scope.End()
+	}
+	return r.Name.End()
+}
+
+func (r *RoleName) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(r.Name.String(level))
+	if r.Scope != nil {
+		builder.WriteString("@")
+		builder.WriteString(r.Scope.String(level))
+	}
+	if r.OnCluster != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(r.OnCluster.String(level))
+	}
+	return builder.String()
+}
+
+func (r *RoleName) Accept(visitor ASTVisitor) error {
+	visitor.enter(r)
+	defer visitor.leave(r)
+	if err := r.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if r.Scope != nil {
+		if err := r.Scope.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if r.OnCluster != nil {
+		if err := r.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitRoleName(r)
+}
+
+type SettingPair struct {
+	Name  *Ident
+	Value Expr
+}
+
+func (s *SettingPair) Pos() Pos {
+	return s.Name.NamePos
+}
+
+func (s *SettingPair) End() Pos {
+	if s.Value == nil {
+		return s.Name.End()
+	}
+	return s.Value.End()
+}
+
+func (s *SettingPair) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(s.Name.String(level))
+	if s.Value != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(s.Value.String(level))
+	}
+	return builder.String()
+}
+
+func (s *SettingPair) Accept(visitor ASTVisitor) error {
+	visitor.enter(s)
+	defer visitor.leave(s)
+	if err := s.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if s.Value != nil {
+		if err := s.Value.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitSettingPair(s)
+}
+
+type RoleSetting struct {
+	SettingPairs []*SettingPair
+	Modifier     *Ident
+}
+
+func (r *RoleSetting) Pos() Pos {
+	if len(r.SettingPairs) > 0 {
+		return r.SettingPairs[0].Pos()
+	}
+	return r.Modifier.NamePos
+}
+
+func (r *RoleSetting) End() Pos {
+	if r.Modifier != nil {
+		return r.Modifier.NameEnd
+	}
+	return r.SettingPairs[len(r.SettingPairs)-1].End()
+}
+
+func (r *RoleSetting) String(level int) string {
+	var builder strings.Builder
+	for i, settingPair := range r.SettingPairs {
+		if i > 0 {
+			builder.WriteString(" ")
+		}
+		builder.WriteString(settingPair.String(level))
+	}
+	if r.Modifier != nil {
+		if len(r.SettingPairs) > 0 {
+			builder.WriteString(" ")
+		}
+		builder.WriteString(r.Modifier.String(level))
+	}
+	return builder.String()
+}
+
+func (r *RoleSetting) Accept(visitor ASTVisitor) error {
+	visitor.enter(r)
+	defer visitor.leave(r)
+	for _, settingPair := range r.SettingPairs {
+		if err := settingPair.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if r.Modifier != nil {
+		if err := r.Modifier.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitRoleSetting(r)
+}
+
+type CreateRole struct {
+	CreatePos         Pos
+	StatementEnd      Pos
+	IfNotExists       bool
+	OrReplace         bool
+	RoleNames         []*RoleName
+	AccessStorageType *Ident
+	Settings          []*RoleSetting
+}
+
+func (c *CreateRole) Pos() Pos {
+	return c.CreatePos
+}
+
+func (c *CreateRole) End() Pos {
+	return c.StatementEnd
+}
+
+func (c *CreateRole) Type() string {
+	return "ROLE"
+}
+
+func (c *CreateRole) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("CREATE ROLE ")
+	if c.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	if c.OrReplace {
+		builder.WriteString("OR REPLACE ")
+	}
+	for i, roleName := range c.RoleNames {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(roleName.String(level))
+	}
+	if c.AccessStorageType != nil {
 		builder.WriteString(NewLine(level))
-		builder.WriteString(c.UUID.String(level))
+		builder.WriteString("IN ")
+		builder.WriteString(c.AccessStorageType.String(level))
+	}
+	if len(c.Settings) > 0 {
+		builder.WriteString(" SETTINGS ")
+		for i, setting := range c.Settings {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(setting.String(level))
+		}
+	}
+	return builder.String()
+}
+
+func (c *CreateRole) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	for _, roleName := range c.RoleNames {
+		if err := roleName.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.AccessStorageType != nil {
+		if err := c.AccessStorageType.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, setting := range c.Settings {
+		if err := setting.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitCreateRole(c)
+}
+
+type AlterRole struct {
+	AlterPos        Pos
+	StatementEnd    Pos
+	IfExists        bool
+	RoleRenamePairs []*RoleRenamePair
+	Settings        []*RoleSetting
+}
+
+func (a *AlterRole) Pos() Pos {
+	return a.AlterPos
+}
+
+func (a *AlterRole) End() Pos {
+	return a.StatementEnd
+}
+
+func (a *AlterRole) Type() string {
+	return "ROLE"
+}
+
+func (a *AlterRole) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("ALTER ROLE ")
+	if a.IfExists {
+		builder.WriteString("IF EXISTS ")
+	}
+	for i, roleRenamePair := range a.RoleRenamePairs {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(roleRenamePair.String(level))
+	}
+	if len(a.Settings) > 0 {
+		builder.WriteString(" SETTINGS ")
+		for i, setting := range a.Settings {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(setting.String(level))
+		}
+	}
+	return builder.String()
+}
+
+func (a *AlterRole) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	for _, roleRenamePair := range a.RoleRenamePairs {
+		if err := roleRenamePair.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, setting := range a.Settings {
+		if err := setting.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterRole(a)
+}
+
+type RoleRenamePair struct {
+	RoleName     *RoleName
+	NewName      Expr
+	StatementEnd Pos
+}
+
+func (r *RoleRenamePair) Pos() Pos {
+	return r.RoleName.Pos()
+}
+
+func (r *RoleRenamePair) End() Pos {
+	return r.StatementEnd
+}
+
+func (r *RoleRenamePair) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(r.RoleName.String(level))
+	if r.NewName != nil {
+		builder.WriteString(" RENAME TO ")
+		builder.WriteString(r.NewName.String(level))
+	}
+	return builder.String()
+}
+
+func (r *RoleRenamePair) Accept(visitor ASTVisitor) error {
+	visitor.enter(r)
+	defer visitor.leave(r)
+	if err := r.RoleName.Accept(visitor); err != nil {
+		return err
+	}
+	if r.NewName != nil {
+		if err := r.NewName.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitRoleRenamePair(r)
+}
+
+// RoleListExpr is a list of roles or users, it's used by the
+// TO, DEFAULT ROLE and GRANTEES clauses of the access entities.
+type RoleListExpr struct {
+	ListPos Pos
+	ListEnd Pos
+	Keyword string // ALL, ANY or NONE
+	Names   []*RoleName
+	Except  []*RoleName
+}
+
+func (r *RoleListExpr) Pos() Pos {
+	return r.ListPos
+}
+
+func (r *RoleListExpr) End() Pos {
+	return r.ListEnd
+}
+
+func (r *RoleListExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(r.Keyword)
+	for i, name := range r.Names {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(name.String(level))
+	}
+	if len(r.Except) > 0 {
+		builder.WriteString(" EXCEPT ")
+		for i, name := range r.Except {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(name.String(level))
+		}
+	}
+	return builder.String()
+}
+
+func (r *RoleListExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(r)
+	defer visitor.leave(r)
+	for _, name := range r.Names {
+		if err := name.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, name := range r.Except {
+		if err := name.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitRoleListExpr(r)
+}
+
+type AuthenticationExpr struct {
+	AuthPos       Pos // position of IDENTIFIED, NOT or ADD keyword
+	AuthEnd       Pos
+	NotIdentified bool
+	IsAdd         bool // ADD IDENTIFIED of ALTER USER
+	Methods       []*AuthenticationMethod
+}
+
+func (a *AuthenticationExpr) Pos() Pos {
+	return a.AuthPos
+}
+
+func (a *AuthenticationExpr) End() Pos {
+	return a.AuthEnd
+}
+
+func (a *AuthenticationExpr) String(level int) string {
+	if a.NotIdentified {
+		return "NOT IDENTIFIED"
+	}
+	var builder strings.Builder
+	if a.IsAdd {
+		builder.WriteString("ADD ")
+	}
+	builder.WriteString("IDENTIFIED")
+	for i, method := range a.Methods {
+		if i > 0 {
+			builder.WriteByte(',')
+		} else if method.Method != nil {
+			builder.WriteString(" WITH")
+		}
+		builder.WriteByte(' ')
+		builder.WriteString(method.String(level))
+	}
+	return builder.String()
+}
+
+func (a *AuthenticationExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	for _, method := range a.Methods {
+		if err := method.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAuthenticationExpr(a)
+}
+
+// AuthenticationMethod is one of the comma separated methods of IDENTIFIED, like `sha256_password BY 'qwerty'`.
+type AuthenticationMethod struct {
+	MethodPos  Pos
+	MethodEnd  Pos
+	Method     *Ident
+	Password   *StringLiteral // BY 'password'
+	Server     *StringLiteral // SERVER 'server' of ldap
+	Realm      *StringLiteral // REALM 'realm' of kerberos
+	CommonName *StringLiteral // CN 'name' of ssl_certificate
+}
+
+func (a *AuthenticationMethod) Pos() Pos {
+	return a.MethodPos
+}
+
+func (a *AuthenticationMethod) End() Pos {
+	return a.MethodEnd
+}
+
+func (a *AuthenticationMethod) String(level int) string {
+	var builder strings.Builder
+	if a.Method != nil {
+		builder.WriteString(a.Method.String(level))
+	}
+	writeValue := func(keyword string, value *StringLiteral) {
+		if value == nil {
+			return
+		}
+		if builder.Len() > 0 {
+			builder.WriteByte(' ')
+		}
+		builder.WriteString(keyword)
+		builder.WriteByte(' ')
+		builder.WriteString(value.String(level))
+	}
+	writeValue("BY", a.Password)
+	writeValue("SERVER", a.Server)
+	writeValue("REALM", a.Realm)
+	writeValue("CN", a.CommonName)
+	return builder.String()
+}
+
+func (a *AuthenticationMethod) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if a.Method != nil {
+		if err := a.Method.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if a.Password != nil {
+		if err := a.Password.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if a.Server != nil {
+		if err := a.Server.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if a.Realm != nil {
+		if err := a.Realm.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if a.CommonName != nil {
+		if err := a.CommonName.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAuthenticationMethod(a)
+}
+
+type HostItem struct {
+	ItemPos Pos
+	ItemEnd Pos
+	Kind    string // LOCAL, ANY, NONE, NAME, REGEXP, IP or LIKE
+	Values  []*StringLiteral
+}
+
+func (h *HostItem) Pos() Pos {
+	return h.ItemPos
+}
+
+func (h *HostItem) End() Pos {
+	return h.ItemEnd
+}
+
+func (h *HostItem) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(h.Kind)
+	for i, value := range h.Values {
+		if i > 0 {
+			builder.WriteByte(',')
+		}
+		builder.WriteByte(' ')
+		builder.WriteString(value.String(level))
+	}
+	return builder.String()
+}
+
+func (h *HostItem) Accept(visitor ASTVisitor) error {
+	visitor.enter(h)
+	defer visitor.leave(h)
+	for _, value := range h.Values {
+		if err := value.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitHostItem(h)
+}
+
+type HostExpr struct {
+	HostPos  Pos // position of HOST keyword, or ADD|DROP keyword in ALTER USER
+	HostEnd  Pos
+	Modifier string // ADD or DROP, only used by ALTER USER
+	Items    []*HostItem
+}
+
+func (h *HostExpr) Pos() Pos {
+	return h.HostPos
+}
+
+func (h *HostExpr) End() Pos {
+	return h.HostEnd
+}
+
+func (h *HostExpr) String(level int) string {
+	var builder strings.Builder
+	if h.Modifier != "" {
+		builder.WriteString(h.Modifier)
+		builder.WriteByte(' ')
+	}
+	builder.WriteString("HOST ")
+	for i, item := range h.Items {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(item.String(level))
+	}
+	return builder.String()
+}
+
+func (h *HostExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(h)
+	defer visitor.leave(h)
+	for _, item := range h.Items {
+		if err := item.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitHostExpr(h)
+}
+
+type CreateUser struct {
+	CreatePos         Pos
+	StatementEnd      Pos
+	IfNotExists       bool
+	OrReplace         bool
+	UserNames         []*RoleName
+	Authentication    *AuthenticationExpr
+	Hosts             *HostExpr
+	ValidUntil        *StringLiteral
+	AccessStorageType *Ident
+	DefaultRole       *RoleListExpr
+	DefaultDatabase   *Ident
+	Grantees          *RoleListExpr
+	Settings          []*RoleSetting
+}
+
+func (c *CreateUser) Pos() Pos {
+	return c.CreatePos
+}
+
+func (c *CreateUser) End() Pos {
+	return c.StatementEnd
+}
+
+func (c *CreateUser) Type() string {
+	return "USER"
+}
+
+func (c *CreateUser) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("CREATE USER ")
+	if c.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
 	}
-	if c.OnCluster != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(c.OnCluster.String(level))
+	if c.OrReplace {
+		builder.WriteString("OR REPLACE ")
 	}
-	if c.TableSchema != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(c.TableSchema.String(level))
+	for i, userName := range c.UserNames {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(userName.String(level))
 	}
-	if c.Engine != nil {
-		builder.WriteString(c.Engine.String(level))
+	if c.Authentication != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(c.Authentication.String(level))
 	}
-	if c.SubQuery != nil {
-		builder.WriteString(c.SubQuery.String(level))
+	if c.Hosts != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(c.Hosts.String(level))
+	}
+	if c.ValidUntil != nil {
+		builder.WriteString(" VALID UNTIL ")
+		builder.WriteString(c.ValidUntil.String(level))
+	}
+	if c.AccessStorageType != nil {
+		builder.WriteString(" IN ")
+		builder.WriteString(c.AccessStorageType.String(level))
+	}
+	if c.DefaultRole != nil {
+		builder.WriteString(" DEFAULT ROLE ")
+		builder.WriteString(c.DefaultRole.String(level))
+	}
+	if c.DefaultDatabase != nil {
+		builder.WriteString(" DEFAULT DATABASE ")
+		builder.WriteString(c.DefaultDatabase.String(level))
+	}
+	if c.Grantees != nil {
+		builder.WriteString(" GRANTEES ")
+		builder.WriteString(c.Grantees.String(level))
+	}
+	if len(c.Settings) > 0 {
+		builder.WriteString(" SETTINGS ")
+		for i, setting := range c.Settings {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(setting.String(level))
+		}
 	}
 	return builder.String()
 }
 
-func (c *CreateTable) Accept(visitor ASTVisitor) error {
+func (c *CreateUser) Accept(visitor ASTVisitor) error {
 	visitor.enter(c)
 	defer visitor.leave(c)
-	if c.UUID != nil {
-		if err := c.UUID.Accept(visitor); err != nil {
+	for _, userName := range c.UserNames {
+		if err := userName.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if c.OnCluster != nil {
-		if err := c.OnCluster.Accept(visitor); err != nil {
+	if c.Authentication != nil {
+		if err := c.Authentication.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if c.TableSchema != nil {
-		if err := c.TableSchema.Accept(visitor); err != nil {
+	if c.Hosts != nil {
+		if err := c.Hosts.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if c.Engine != nil {
-		if err := c.Engine.Accept(visitor); err != nil {
+	if c.ValidUntil != nil {
+		if err := c.ValidUntil.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if c.SubQuery != nil {
-		if err := c.SubQuery.Accept(visitor); err != nil {
+	if c.AccessStorageType != nil {
+		if err := c.AccessStorageType.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitCreateTable(c)
+	if c.DefaultRole != nil {
+		if err := c.DefaultRole.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.DefaultDatabase != nil {
+		if err := c.DefaultDatabase.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Grantees != nil {
+		if err := c.Grantees.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, setting := range c.Settings {
+		if err := setting.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitCreateUser(c)
 }
 
-type CreateMaterializedView struct {
-	CreatePos    Pos // position of CREATE|ATTACH keyword
-	StatementEnd Pos
-	Name         *TableIdentifier
-	IfNotExists  bool
-	OnCluster    *OnClusterExpr
-	Engine       *EngineExpr
-	Destination  *DestinationExpr
-	SubQuery     *SubQueryExpr
-	Populate     bool
+type AlterUser struct {
+	AlterPos        Pos
+	StatementEnd    Pos
+	IfExists        bool
+	UserRenamePairs []*RoleRenamePair
+	Authentication  *AuthenticationExpr
+	ResetAuth       bool // RESET AUTHENTICATION METHODS TO NEW
+	Hosts           []*HostExpr
+	ValidUntil      *StringLiteral
+	DefaultRole     *RoleListExpr
+	DefaultDatabase *Ident
+	Grantees        *RoleListExpr
+	Settings        []*RoleSetting
 }
 
-func (c *CreateMaterializedView) Pos() Pos {
-	return c.CreatePos
+func (a *AlterUser) Pos() Pos {
+	return a.AlterPos
 }
 
-func (c *CreateMaterializedView) End() Pos {
-	return c.StatementEnd
+func (a *AlterUser) End() Pos {
+	return a.StatementEnd
 }
 
-func (c *CreateMaterializedView) Type() string {
-	return "MATERIALIZED_VIEW"
+func (a *AlterUser) Type() string {
+	return "USER"
 }
 
-func (c *CreateMaterializedView) String(level int) string {
+func (a *AlterUser) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("CREATE MATERIALIZED VIEW ")
-	if c.IfNotExists {
-		builder.WriteString("IF NOT EXISTS ")
+	builder.WriteString("ALTER USER ")
+	if a.IfExists {
+		builder.WriteString("IF EXISTS ")
 	}
-	builder.WriteString(c.Name.String(level))
-	if c.OnCluster != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(c.OnCluster.String(level))
+	for i, userRenamePair := range a.UserRenamePairs {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(userRenamePair.String(level))
 	}
-	if c.Engine != nil {
-		builder.WriteString(c.Engine.String(level))
+	if a.Authentication != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(a.Authentication.String(level))
 	}
-	if c.Destination != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(c.Destination.String(level))
-		if c.Destination.TableSchema != nil {
-			builder.WriteString(NewLine(level))
-			// level + 1 to add an indent for table schema
-			builder.WriteString(c.Destination.TableSchema.String(level + 1))
-		}
+	if a.ResetAuth {
+		builder.WriteString(" RESET AUTHENTICATION METHODS TO NEW")
 	}
-	if c.Populate {
-		builder.WriteString(" POPULATE ")
+	for _, host := range a.Hosts {
+		builder.WriteByte(' ')
+		builder.WriteString(host.String(level))
 	}
-	if c.SubQuery != nil {
-		builder.WriteString(c.SubQuery.String(level))
+	if a.ValidUntil != nil {
+		builder.WriteString(" VALID UNTIL ")
+		builder.WriteString(a.ValidUntil.String(level))
+	}
+	if a.DefaultRole != nil {
+		builder.WriteString(" DEFAULT ROLE ")
+		builder.WriteString(a.DefaultRole.String(level))
+	}
+	if a.DefaultDatabase != nil {
+		builder.WriteString(" DEFAULT DATABASE ")
+		builder.WriteString(a.DefaultDatabase.String(level))
+	}
+	if a.Grantees != nil {
+		builder.WriteString(" GRANTEES ")
+		builder.WriteString(a.Grantees.String(level))
+	}
+	if len(a.Settings) > 0 {
+		builder.WriteString(" SETTINGS ")
+		for i, setting := range a.Settings {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(setting.String(level))
+		}
 	}
 	return builder.String()
 }
 
-func (c *CreateMaterializedView) Accept(visitor ASTVisitor) error {
-	visitor.enter(c)
-	defer visitor.leave(c)
-	if err := c.Name.Accept(visitor); err != nil {
-		return err
+func (a *AlterUser) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	for _, userRenamePair := range a.UserRenamePairs {
+		if err := userRenamePair.Accept(visitor); err != nil {
+			return err
+		}
 	}
-	if c.OnCluster != nil {
-		if err := c.OnCluster.Accept(visitor); err != nil {
+	if a.Authentication != nil {
+		if err := a.Authentication.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if c.Engine != nil {
-		if err := c.Engine.Accept(visitor); err != nil {
+	for _, host := range a.Hosts {
+		if err := host.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if c.Destination != nil {
-		if err := c.Destination.Accept(visitor); err != nil {
+	if a.ValidUntil != nil {
+		if err := a.ValidUntil.Accept(visitor); err != nil {
 			return err
 		}
-		if c.Destination.TableSchema != nil {
-			if err := c.Destination.TableSchema.Accept(visitor); err != nil {
-				return err
+	}
+	if a.DefaultRole != nil {
+		if err := a.DefaultRole.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if a.DefaultDatabase != nil {
+		if err := a.DefaultDatabase.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if a.Grantees != nil {
+		if err := a.Grantees.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, setting := range a.Settings {
+		if err := setting.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterUser(a)
+}
+
+type QuotaLimitExpr struct {
+	Name  *Ident
+	Value *NumberLiteral
+}
+
+func (q *QuotaLimitExpr) Pos() Pos {
+	return q.Name.NamePos
+}
+
+func (q *QuotaLimitExpr) End() Pos {
+	return q.Value.End()
+}
+
+func (q *QuotaLimitExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(q.Name.String(level))
+	builder.WriteString(" = ")
+	builder.WriteString(q.Value.String(level))
+	return builder.String()
+}
+
+func (q *QuotaLimitExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(q)
+	defer visitor.leave(q)
+	if err := q.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if err := q.Value.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitQuotaLimitExpr(q)
+}
+
+type QuotaIntervalExpr struct {
+	ForPos       Pos
+	IntervalEnd  Pos
+	Randomized   bool
+	Interval     *IntervalExpr
+	Limits       []*QuotaLimitExpr
+	NoLimits     bool
+	TrackingOnly bool
+}
+
+func (q *QuotaIntervalExpr) Pos() Pos {
+	return q.ForPos
+}
+
+func (q *QuotaIntervalExpr) End() Pos {
+	return q.IntervalEnd
+}
+
+func (q *QuotaIntervalExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("FOR ")
+	if q.Randomized {
+		builder.WriteString("RANDOMIZED ")
+	}
+	builder.WriteString(q.Interval.String(level))
+	switch {
+	case q.NoLimits:
+		builder.WriteString(" NO LIMITS")
+	case q.TrackingOnly:
+		builder.WriteString(" TRACKING ONLY")
+	default:
+		builder.WriteString(" MAX ")
+		for i, limit := range q.Limits {
+			if i > 0 {
+				builder.WriteString(", ")
 			}
+			builder.WriteString(limit.String(level))
 		}
 	}
-	if c.SubQuery != nil {
-		if err := c.SubQuery.Accept(visitor); err != nil {
+	return builder.String()
+}
+
+func (q *QuotaIntervalExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(q)
+	defer visitor.leave(q)
+	if err := q.Interval.Accept(visitor); err != nil {
+		return err
+	}
+	for _, limit := range q.Limits {
+		if err := limit.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitCreateMaterializedView(c)
+	return visitor.VisitQuotaIntervalExpr(q)
 }
 
-type CreateView struct {
-	CreatePos    Pos // position of CREATE|ATTACH keyword
-	StatementEnd Pos
-	Name         *TableIdentifier
-	IfNotExists  bool
-	UUID         *UUID
-	OnCluster    *OnClusterExpr
-	TableSchema  *TableSchemaExpr
-	SubQuery     *SubQueryExpr
+type CreateQuota struct {
+	CreatePos         Pos
+	StatementEnd      Pos
+	IfNotExists       bool
+	OrReplace         bool
+	Names             []*RoleName
+	AccessStorageType *Ident
+	KeyedBy           []*Ident
+	NotKeyed          bool
+	Intervals         []*QuotaIntervalExpr
+	To                *RoleListExpr
 }
 
-func (c *CreateView) Pos() Pos {
+func (c *CreateQuota) Pos() Pos {
 	return c.CreatePos
 }
 
-func (c *CreateView) End() Pos {
+func (c *CreateQuota) End() Pos {
 	return c.StatementEnd
 }
 
-func (c *CreateView) Type() string {
-	return "VIEW"
+func (c *CreateQuota) Type() string {
+	return "QUOTA"
 }
 
-func (c *CreateView) String(level int) string {
+func (c *CreateQuota) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("CREATE VIEW ")
+	builder.WriteString("CREATE QUOTA ")
 	if c.IfNotExists {
 		builder.WriteString("IF NOT EXISTS ")
 	}
-	builder.WriteString(c.Name.String(level))
-	if c.UUID != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(c.UUID.String(level))
-	}
-
-	if c.OnCluster != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(c.OnCluster.String(level))
+	if c.OrReplace {
+		builder.WriteString("OR REPLACE ")
 	}
-
-	if c.TableSchema != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(c.TableSchema.String(level))
+	for i, name := range c.Names {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(name.String(level))
 	}
-
-	if c.SubQuery != nil {
-		builder.WriteString(c.SubQuery.String(level))
+	if c.AccessStorageType != nil {
+		builder.WriteString(" IN ")
+		builder.WriteString(c.AccessStorageType.String(level))
 	}
+	writeQuotaClauses(&builder, level, c.KeyedBy, c.NotKeyed, c.Intervals, c.To)
 	return builder.String()
 }
 
-func (c *CreateView) Accept(visitor ASTVisitor) error {
+func (c *CreateQuota) Accept(visitor ASTVisitor) error {
 	visitor.enter(c)
 	defer visitor.leave(c)
-	if err := c.Name.Accept(visitor); err != nil {
-		return err
+	for _, name := range c.Names {
+		if err := name.Accept(visitor); err != nil {
+			return err
+		}
 	}
-	if c.UUID != nil {
-		if err := c.UUID.Accept(visitor); err != nil {
+	if c.AccessStorageType != nil {
+		if err := c.AccessStorageType.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if c.OnCluster != nil {
-		if err := c.OnCluster.Accept(visitor); err != nil {
+	for _, key := range c.KeyedBy {
+		if err := key.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if c.TableSchema != nil {
-		if err := c.TableSchema.Accept(visitor); err != nil {
+	for _, interval := range c.Intervals {
+		if err := interval.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if c.SubQuery != nil {
-		if err := c.SubQuery.Accept(visitor); err != nil {
+	if c.To != nil {
+		if err := c.To.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitCreateView(c)
+	return visitor.VisitCreateQuota(c)
 }
 
-type CreateFunction struct {
-	CreatePos    Pos
-	IfNotExists  bool
-	FunctionName *Ident
-	OnCluster    *OnClusterExpr
-	Params       *ParamExprList
-	Expr         Expr
+type AlterQuota struct {
+	AlterPos         Pos
+	StatementEnd     Pos
+	IfExists         bool
+	QuotaRenamePairs []*RoleRenamePair
+	KeyedBy          []*Ident
+	NotKeyed         bool
+	Intervals        []*QuotaIntervalExpr
+	To               *RoleListExpr
 }
 
-func (c *CreateFunction) Type() string {
-	return "FUNCTION"
+func (a *AlterQuota) Pos() Pos {
+	return a.AlterPos
 }
 
-func (c *CreateFunction) Pos() Pos {
-	return c.CreatePos
+func (a *AlterQuota) End() Pos {
+	return a.StatementEnd
 }
 
-func (c *CreateFunction) End() Pos {
-	return c.Expr.End()
+func (a *AlterQuota) Type() string {
+	return "QUOTA"
 }
 
-func (c *CreateFunction) String(level int) string {
+func (a *AlterQuota) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("CREATE FUNCTION ")
-	if c.IfNotExists {
-		builder.WriteString("IF NOT EXISTS ")
+	builder.WriteString("ALTER QUOTA ")
+	if a.IfExists {
+		builder.WriteString("IF EXISTS ")
 	}
-	builder.WriteString(c.FunctionName.String(level))
-	if c.OnCluster != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(c.OnCluster.String(level))
+	for i, quotaRenamePair := range a.QuotaRenamePairs {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(quotaRenamePair.String(level))
 	}
-	builder.WriteString(" AS ")
-	builder.WriteString(c.Params.String(level))
-	builder.WriteString(" -> ")
-	builder.WriteString(c.Expr.String(level))
+	writeQuotaClauses(&builder, level, a.KeyedBy, a.NotKeyed, a.Intervals, a.To)
 	return builder.String()
 }
 
-func (c *CreateFunction) Accept(visitor ASTVisitor) error {
-	visitor.enter(c)
-	defer visitor.leave(c)
-	if err := c.FunctionName.Accept(visitor); err != nil {
-		return err
+func (a *AlterQuota) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	for _, quotaRenamePair := range a.QuotaRenamePairs {
+		if err := quotaRenamePair.Accept(visitor); err != nil {
+			return err
+		}
 	}
-	if c.OnCluster != nil {
-		if err := c.OnCluster.Accept(visitor); err != nil {
+	for _, key := range a.KeyedBy {
+		if err := key.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if err := c.Params.Accept(visitor); err != nil {
-		return err
+	for _, interval := range a.Intervals {
+		if err := interval.Accept(visitor); err != nil {
+			return err
+		}
 	}
-	if err := c.Expr.Accept(visitor); err != nil {
-		return err
+	if a.To != nil {
+		if err := a.To.Accept(visitor); err != nil {
+			return err
+		}
 	}
-	return visitor.VisitCreateFunction(c)
+	return visitor.VisitAlterQuota(a)
 }
 
-type RoleName struct {
-	Name      Expr
-	Scope     *StringLiteral
+func writeQuotaClauses(builder *strings.Builder, level int, keyedBy []*Ident, notKeyed bool, intervals []*QuotaIntervalExpr, to *RoleListExpr) {
+	if notKeyed {
+		builder.WriteString(" NOT KEYED")
+	}
+	if len(keyedBy) > 0 {
+		builder.WriteString(" KEYED BY ")
+		for i, key := range keyedBy {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(key.String(level))
+		}
+	}
+	for i, interval := range intervals {
+		if i > 0 {
+			builder.WriteByte(',')
+		}
+		builder.WriteByte(' ')
+		builder.WriteString(interval.String(level))
+	}
+	if to != nil {
+		builder.WriteString(" TO ")
+		builder.WriteString(to.String(level))
+	}
+}
+
+type RowPolicyName struct {
+	Name      *Ident
 	OnCluster *OnClusterExpr
+	OnPos     Pos
+	On        *TableIdentifier
+	NewName   *Ident // RENAME TO new_name, only used by ALTER ROW POLICY
 }
 
-func (r *RoleName) Pos() Pos {
-	return r.Name.Pos()
+func (r *RowPolicyName) Pos() Pos {
+	return r.Name.NamePos
 }
 
-func (r *RoleName) End() Pos {
-	if r.Scope != nil {
-		return r.Scope.End()
-	}
-	if r.OnCluster != nil {
-		return r.OnCluster.End()
+func (r *RowPolicyName) End() Pos {
+	if r.NewName != nil {
+		return r.NewName.NameEnd
 	}
-	return r.Name.End()
+	return r.On.End()
 }
 
-func (r *RoleName) String(level int) string {
+func (r *RowPolicyName) String(level int) string {
 	var builder strings.Builder
 	builder.WriteString(r.Name.String(level))
-	if r.Scope != nil {
-		builder.WriteString("@")
-		builder.WriteString(r.Scope.String(level))
-	}
 	if r.OnCluster != nil {
-		builder.WriteString(" ON ")
+		builder.WriteByte(' ')
 		builder.WriteString(r.OnCluster.String(level))
 	}
+	builder.WriteString(" ON ")
+	builder.WriteString(r.On.String(level))
+	if r.NewName != nil {
+		builder.WriteString(" RENAME TO ")
+		builder.WriteString(r.NewName.String(level))
+	}
 	return builder.String()
 }
 
-func (r *RoleName) Accept(visitor ASTVisitor) error {
+func (r *RowPolicyName) Accept(visitor ASTVisitor) error {
 	visitor.enter(r)
 	defer visitor.leave(r)
 	if err := r.Name.Accept(visitor); err != nil {
 		return err
 	}
-	if r.Scope != nil {
-		if err := r.Scope.Accept(visitor); err != nil {
+	if r.OnCluster != nil {
+		if err := r.OnCluster.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if r.OnCluster != nil {
-		if err := r.OnCluster.Accept(visitor); err != nil {
+	if err := r.On.Accept(visitor); err != nil {
+		return err
+	}
+	if r.NewName != nil {
+		if err := r.NewName.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitRoleName(r)
+	return visitor.VisitRowPolicyName(r)
 }
 
-type SettingPair struct {
-	Name  *Ident
-	Value Expr
+type CreateRowPolicy struct {
+	CreatePos         Pos
+	StatementEnd      Pos
+	IfNotExists       bool
+	OrReplace         bool
+	Policies          []*RowPolicyName
+	AccessStorageType *Ident
+	ForSelect         bool
+	Using             Expr
+	Kind              string // PERMISSIVE or RESTRICTIVE
+	To                *RoleListExpr
 }
 
-func (s *SettingPair) Pos() Pos {
-	return s.Name.NamePos
+func (c *CreateRowPolicy) Pos() Pos {
+	return c.CreatePos
 }
 
-func (s *SettingPair) End() Pos {
-	return s.Value.End()
+func (c *CreateRowPolicy) End() Pos {
+	return c.StatementEnd
 }
 
-func (s *SettingPair) String(level int) string {
+func (c *CreateRowPolicy) Type() string {
+	return "ROW POLICY"
+}
+
+func (c *CreateRowPolicy) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString(s.Name.String(level))
-	if s.Value != nil {
-		builder.WriteByte(' ')
-		builder.WriteString(s.Value.String(level))
+	builder.WriteString("CREATE ROW POLICY ")
+	if c.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
 	}
+	if c.OrReplace {
+		builder.WriteString("OR REPLACE ")
+	}
+	for i, policy := range c.Policies {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(policy.String(level))
+	}
+	if c.AccessStorageType != nil {
+		builder.WriteString(" IN ")
+		builder.WriteString(c.AccessStorageType.String(level))
+	}
+	writeRowPolicyClauses(&builder, level, c.ForSelect, c.Using, c.Kind, c.To)
 	return builder.String()
 }
 
-func (s *SettingPair) Accept(visitor ASTVisitor) error {
-	visitor.enter(s)
-	defer visitor.leave(s)
-	if err := s.Name.Accept(visitor); err != nil {
-		return err
+func (c *CreateRowPolicy) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	for _, policy := range c.Policies {
+		if err := policy.Accept(visitor); err != nil {
+			return err
+		}
 	}
-	if s.Value != nil {
-		if err := s.Value.Accept(visitor); err != nil {
+	if c.AccessStorageType != nil {
+		if err := c.AccessStorageType.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitSettingPair(s)
+	if c.Using != nil {
+		if err := c.Using.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.To != nil {
+		if err := c.To.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitCreateRowPolicy(c)
 }
 
-type RoleSetting struct {
-	SettingPairs []*SettingPair
-	Modifier     *Ident
+type AlterRowPolicy struct {
+	AlterPos     Pos
+	StatementEnd Pos
+	IfExists     bool
+	Policies     []*RowPolicyName
+	ForSelect    bool
+	Using        Expr
+	Kind         string // PERMISSIVE or RESTRICTIVE
+	To           *RoleListExpr
 }
 
-func (r *RoleSetting) Pos() Pos {
-	if len(r.SettingPairs) > 0 {
-		return r.SettingPairs[0].Pos()
-	}
-	return r.Modifier.NamePos
+func (a *AlterRowPolicy) Pos() Pos {
+	return a.AlterPos
 }
 
-func (r *RoleSetting) End() Pos {
-	if r.Modifier != nil {
-		return r.Modifier.NameEnd
-	}
-	return r.SettingPairs[len(r.SettingPairs)-1].End()
+func (a *AlterRowPolicy) End() Pos {
+	return a.StatementEnd
 }
 
-func (r *RoleSetting) String(level int) string {
+func (a *AlterRowPolicy) Type() string {
+	return "ROW POLICY"
+}
+
+func (a *AlterRowPolicy) String(level int) string {
 	var builder strings.Builder
-	for i, settingPair := range r.SettingPairs {
+	builder.WriteString("ALTER ROW POLICY ")
+	if a.IfExists {
+		builder.WriteString("IF EXISTS ")
+	}
+	for i, policy := range a.Policies {
 		if i > 0 {
-			builder.WriteString(" ")
+			builder.WriteString(", ")
 		}
-		builder.WriteString(settingPair.String(level))
-	}
-	if r.Modifier != nil {
-		builder.WriteString(" ")
-		builder.WriteString(r.Modifier.String(level))
+		builder.WriteString(policy.String(level))
 	}
+	writeRowPolicyClauses(&builder, level, a.ForSelect, a.Using, a.Kind, a.To)
 	return builder.String()
 }
 
-func (r *RoleSetting) Accept(visitor ASTVisitor) error {
-	visitor.enter(r)
-	defer visitor.leave(r)
-	for _, settingPair := range r.SettingPairs {
-		if err := settingPair.Accept(visitor); err != nil {
+func (a *AlterRowPolicy) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	for _, policy := range a.Policies {
+		if err := policy.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if r.Modifier != nil {
-		if err := r.Modifier.Accept(visitor); err != nil {
+	if a.Using != nil {
+		if err := a.Using.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitRoleSetting(r)
+	if a.To != nil {
+		if err := a.To.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterRowPolicy(a)
 }
 
-type CreateRole struct {
+func writeRowPolicyClauses(builder *strings.Builder, level int, forSelect bool, using Expr, kind string, to *RoleListExpr) {
+	if forSelect {
+		builder.WriteString(" FOR SELECT")
+	}
+	if using != nil {
+		builder.WriteString(" USING ")
+		builder.WriteString(using.String(level))
+	}
+	if kind != "" {
+		builder.WriteString(" AS ")
+		builder.WriteString(kind)
+	}
+	if to != nil {
+		builder.WriteString(" TO ")
+		builder.WriteString(to.String(level))
+	}
+}
+
+type CreateSettingsProfile struct {
 	CreatePos         Pos
 	StatementEnd      Pos
 	IfNotExists       bool
 	OrReplace         bool
-	RoleNames         []*RoleName
+	Names             []*RoleName
 	AccessStorageType *Ident
 	Settings          []*RoleSetting
+	To                *RoleListExpr
 }
 
-func (c *CreateRole) Pos() Pos {
+func (c *CreateSettingsProfile) Pos() Pos {
 	return c.CreatePos
 }
 
-func (c *CreateRole) End() Pos {
+func (c *CreateSettingsProfile) End() Pos {
 	return c.StatementEnd
 }
 
-func (c *CreateRole) Type() string {
-	return "ROLE"
+func (c *CreateSettingsProfile) Type() string {
+	return "SETTINGS PROFILE"
 }
 
-func (c *CreateRole) String(level int) string {
+func (c *CreateSettingsProfile) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("CREATE ROLE ")
+	builder.WriteString("CREATE SETTINGS PROFILE ")
 	if c.IfNotExists {
 		builder.WriteString("IF NOT EXISTS ")
 	}
 	if c.OrReplace {
 		builder.WriteString("OR REPLACE ")
 	}
-	for i, roleName := range c.RoleNames {
+	for i, name := range c.Names {
 		if i > 0 {
 			builder.WriteString(", ")
 		}
-		builder.WriteString(roleName.String(level))
+		builder.WriteString(name.String(level))
 	}
 	if c.AccessStorageType != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString("IN ")
+		builder.WriteString(" IN ")
 		builder.WriteString(c.AccessStorageType.String(level))
 	}
 	if len(c.Settings) > 0 {
@@ -1556,14 +4562,18 @@ func (c *CreateRole) String(level int) string {
 			builder.WriteString(setting.String(level))
 		}
 	}
+	if c.To != nil {
+		builder.WriteString(" TO ")
+		builder.WriteString(c.To.String(level))
+	}
 	return builder.String()
 }
 
-func (c *CreateRole) Accept(visitor ASTVisitor) error {
+func (c *CreateSettingsProfile) Accept(visitor ASTVisitor) error {
 	visitor.enter(c)
 	defer visitor.leave(c)
-	for _, roleName := range c.RoleNames {
-		if err := roleName.Accept(visitor); err != nil {
+	for _, name := range c.Names {
+		if err := name.Accept(visitor); err != nil {
 			return err
 		}
 	}
@@ -1577,40 +4587,46 @@ func (c *CreateRole) Accept(visitor ASTVisitor) error {
 			return err
 		}
 	}
-	return visitor.VisitCreateRole(c)
+	if c.To != nil {
+		if err := c.To.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitCreateSettingsProfile(c)
 }
-
-type AlterRole struct {
-	AlterPos        Pos
-	StatementEnd    Pos
-	IfExists        bool
-	RoleRenamePairs []*RoleRenamePair
-	Settings        []*RoleSetting
+
+type AlterSettingsProfile struct {
+	AlterPos           Pos
+	StatementEnd       Pos
+	IfExists           bool
+	ProfileRenamePairs []*RoleRenamePair
+	Settings           []*RoleSetting
+	To                 *RoleListExpr
 }
 
-func (a *AlterRole) Pos() Pos {
+func (a *AlterSettingsProfile) Pos() Pos {
 	return a.AlterPos
 }
 
-func (a *AlterRole) End() Pos {
+func (a *AlterSettingsProfile) End() Pos {
 	return a.StatementEnd
 }
 
-func (a *AlterRole) Type() string {
-	return "ROLE"
+func (a *AlterSettingsProfile) Type() string {
+	return "SETTINGS PROFILE"
 }
 
-func (a *AlterRole) String(level int) string {
+func (a *AlterSettingsProfile) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("ALTER ROLE ")
+	builder.WriteString("ALTER SETTINGS PROFILE ")
 	if a.IfExists {
 		builder.WriteString("IF EXISTS ")
 	}
-	for i, roleRenamePair := range a.RoleRenamePairs {
+	for i, profileRenamePair := range a.ProfileRenamePairs {
 		if i > 0 {
 			builder.WriteString(", ")
 		}
-		builder.WriteString(roleRenamePair.String(level))
+		builder.WriteString(profileRenamePair.String(level))
 	}
 	if len(a.Settings) > 0 {
 		builder.WriteString(" SETTINGS ")
@@ -1621,14 +4637,18 @@ func (a *AlterRole) String(level int) string {
 			builder.WriteString(setting.String(level))
 		}
 	}
+	if a.To != nil {
+		builder.WriteString(" TO ")
+		builder.WriteString(a.To.String(level))
+	}
 	return builder.String()
 }
 
-func (a *AlterRole) Accept(visitor ASTVisitor) error {
+func (a *AlterSettingsProfile) Accept(visitor ASTVisitor) error {
 	visitor.enter(a)
 	defer visitor.leave(a)
-	for _, roleRenamePair := range a.RoleRenamePairs {
-		if err := roleRenamePair.Accept(visitor); err != nil {
+	for _, profileRenamePair := range a.ProfileRenamePairs {
+		if err := profileRenamePair.Accept(visitor); err != nil {
 			return err
 		}
 	}
@@ -1637,45 +4657,12 @@ func (a *AlterRole) Accept(visitor ASTVisitor) error {
 			return err
 		}
 	}
-	return visitor.VisitAlterRole(a)
-}
-
-type RoleRenamePair struct {
-	RoleName     *RoleName
-	NewName      Expr
-	StatementEnd Pos
-}
-
-func (r *RoleRenamePair) Pos() Pos {
-	return r.RoleName.Pos()
-}
-
-func (r *RoleRenamePair) End() Pos {
-	return r.StatementEnd
-}
-
-func (r *RoleRenamePair) String(level int) string {
-	var builder strings.Builder
-	builder.WriteString(r.RoleName.String(level))
-	if r.NewName != nil {
-		builder.WriteString(" RENAME TO ")
-		builder.WriteString(r.NewName.String(level))
-	}
-	return builder.String()
-}
-
-func (r *RoleRenamePair) Accept(visitor ASTVisitor) error {
-	visitor.enter(r)
-	defer visitor.leave(r)
-	if err := r.RoleName.Accept(visitor); err != nil {
-		return err
-	}
-	if r.NewName != nil {
-		if err := r.NewName.Accept(visitor); err != nil {
+	if a.To != nil {
+		if err := a.To.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitRoleRenamePair(r)
+	return visitor.VisitAlterSettingsProfile(a)
 }
 
 type DestinationExpr struct {
@@ -1711,6 +4698,7 @@ func (d *DestinationExpr) Accept(visitor ASTVisitor) error {
 type ConstraintExpr struct {
 	ConstraintPos Pos
 	Constraint    *Ident
+	Kind          string // CHECK or ASSUME
 	Expr          Expr
 }
 
@@ -1726,6 +4714,10 @@ func (c *ConstraintExpr) String(level int) string {
 	var builder strings.Builder
 	builder.WriteString(c.Constraint.String(level))
 	builder.WriteByte(' ')
+	if c.Kind != "" {
+		builder.WriteString(c.Kind)
+		builder.WriteByte(' ')
+	}
 	builder.WriteString(c.Expr.String(level))
 	return builder.String()
 }
@@ -1879,44 +4871,67 @@ func (c *ColumnIdentifier) Accept(visitor ASTVisitor) error {
 type TableIdentifier struct {
 	Database *Ident
 	Table    *Ident
+	// DatabaseParam and TableParam are set instead of Database and Table
+	// when the names are query parameters, like {db:Identifier}.{tbl:Identifier}
+	DatabaseParam *QueryParam `json:",omitempty"`
+	TableParam    *QueryParam `json:",omitempty"`
 }
 
-func (t *TableIdentifier) Pos() Pos {
+func (t *TableIdentifier) database() Expr {
+	if t.DatabaseParam != nil {
+		return t.DatabaseParam
+	}
 	if t.Database != nil {
-		return t.Database.Pos()
+		return t.Database
+	}
+	return nil
+}
+
+func (t *TableIdentifier) table() Expr {
+	if t.TableParam != nil {
+		return t.TableParam
+	}
+	return t.Table
+}
+
+func (t *TableIdentifier) Pos() Pos {
+	if database := t.database(); database != nil {
+		return database.Pos()
 	}
-	return t.Table.Pos()
+	return t.table().Pos()
 }
 
 func (t *TableIdentifier) End() Pos {
-	return t.Table.End()
+	return t.table().End()
 }
 
 func (t *TableIdentifier) String(int) string {
-	if t.Database != nil {
-		return t.Database.String(0) + "." + t.Table.String(0)
+	if database := t.database(); database != nil {
+		return database.String(0) + "." + t.table().String(0)
 	}
-	return t.Table.String(0)
+	return t.table().String(0)
 }
 
 func (t *TableIdentifier) Accept(visitor ASTVisitor) error {
 	visitor.enter(t)
 	defer visitor.leave(t)
-	if t.Database != nil {
-		if err := t.Database.Accept(visitor); err != nil {
+	if database := t.database(); database != nil {
+		if err := database.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if err := t.Table.Accept(visitor); err != nil {
+	if err := t.table().Accept(visitor); err != nil {
 		return err
 	}
 	return visitor.VisitTableIdentifier(t)
 }
 
 type TableSchemaExpr struct {
-	SchemaPos     Pos
-	SchemaEnd     Pos
+	SchemaPos Pos
+	SchemaEnd Pos
+	// Columns are the *Column, *TableIndex, *TableProjection and *ConstraintExpr
 	Columns       []Expr
+	Clone         bool // CLONE AS table
 	AliasTable    *TableIdentifier
 	TableFunction *TableFunctionExpr
 }
@@ -1938,17 +4953,28 @@ func (t *TableSchemaExpr) String(level int) string {
 				builder.WriteByte(',')
 			}
 			builder.WriteString(NewLine(level + 1))
+			switch column.(type) {
+			case *TableIndex:
+				builder.WriteString("INDEX ")
+			case *TableProjection:
+				builder.WriteString("PROJECTION ")
+			case *ConstraintExpr:
+				builder.WriteString("CONSTRAINT ")
+			}
 			builder.WriteString(column.String(level))
 		}
 		builder.WriteString(NewLine(level - 1))
 		builder.WriteByte(')')
 	}
+	if t.Clone {
+		builder.WriteString("CLONE")
+	}
 	if t.AliasTable != nil {
 		builder.WriteString(" AS ")
 		builder.WriteString(t.AliasTable.String(level))
 	}
 	if t.TableFunction != nil {
-		builder.WriteByte(' ')
+		builder.WriteString(" AS ")
 		builder.WriteString(t.TableFunction.String(level))
 	}
 	return builder.String()
@@ -2125,6 +5151,7 @@ func (p *PartitionExpr) String(level int) string {
 	var builder strings.Builder
 	builder.WriteString("PARTITION ")
 	if p.ID != nil {
+		builder.WriteString("ID ")
 		builder.WriteString(p.ID.String(level))
 	} else if p.All {
 		builder.WriteString("ALL")
@@ -2237,9 +5264,27 @@ func (s *SampleByExpr) Accept(visitor ASTVisitor) error {
 	return visitor.VisitSampleByExpr(s)
 }
 
+type TTLAction string
+
+const (
+	TTLActionNone       TTLAction = "None"
+	TTLActionDelete     TTLAction = "DELETE"
+	TTLActionToDisk     TTLAction = "TO DISK"
+	TTLActionToVolume   TTLAction = "TO VOLUME"
+	TTLActionRecompress TTLAction = "RECOMPRESS"
+	TTLActionGroupBy    TTLAction = "GROUP BY"
+)
+
 type TTLExpr struct {
-	TTLPos Pos
-	Expr   Expr
+	TTLPos  Pos
+	TTLEnd  Pos
+	Expr    Expr
+	Action  TTLAction
+	Target  *StringLiteral    // disk or volume of TO DISK and TO VOLUME
+	Codec   *CompressionCodec // codec of RECOMPRESS
+	Where   Expr
+	GroupBy []Expr
+	Set     []*UpdateAssignment
 }
 
 func (t *TTLExpr) Pos() Pos {
@@ -2247,12 +5292,45 @@ func (t *TTLExpr) Pos() Pos {
 }
 
 func (t *TTLExpr) End() Pos {
-	return t.Expr.End()
+	return t.TTLEnd
 }
 
 func (t *TTLExpr) String(level int) string {
 	var builder strings.Builder
 	builder.WriteString(t.Expr.String(level))
+	switch t.Action {
+	case TTLActionDelete:
+		builder.WriteString(" DELETE")
+	case TTLActionToDisk, TTLActionToVolume:
+		builder.WriteByte(' ')
+		builder.WriteString(string(t.Action))
+		builder.WriteByte(' ')
+		builder.WriteString(t.Target.String(level))
+	case TTLActionRecompress:
+		builder.WriteString(" RECOMPRESS ")
+		builder.WriteString(t.Codec.String(level))
+	case TTLActionGroupBy:
+		builder.WriteString(" GROUP BY ")
+		for i, key := range t.GroupBy {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(key.String(level))
+		}
+		if len(t.Set) > 0 {
+			builder.WriteString(" SET ")
+			for i, assignment := range t.Set {
+				if i > 0 {
+					builder.WriteString(", ")
+				}
+				builder.WriteString(assignment.String(level))
+			}
+		}
+	}
+	if t.Where != nil {
+		builder.WriteString(" WHERE ")
+		builder.WriteString(t.Where.String(level))
+	}
 	return builder.String()
 }
 
@@ -2262,6 +5340,31 @@ func (t *TTLExpr) Accept(visitor ASTVisitor) error {
 	if err := t.Expr.Accept(visitor); err != nil {
 		return err
 	}
+	if t.Target != nil {
+		if err := t.Target.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if t.Codec != nil {
+		if err := t.Codec.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, key := range t.GroupBy {
+		if err := key.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, assignment := range t.Set {
+		if err := assignment.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if t.Where != nil {
+		if err := t.Where.Accept(visitor); err != nil {
+			return err
+		}
+	}
 	return visitor.VisitTTLExpr(t)
 }
 
@@ -2284,7 +5387,7 @@ func (t *TTLExprList) String(level int) string {
 	builder.WriteString("TTL ")
 	for i, item := range t.Items {
 		if i > 0 {
-			builder.WriteString(",")
+			builder.WriteString(", ")
 		}
 		builder.WriteString(item.String(level))
 	}
@@ -2306,6 +5409,9 @@ type OrderByExpr struct {
 	OrderPos  Pos
 	Expr      Expr
 	Direction OrderDirection
+	Nulls     string // FIRST or LAST
+	Collate   *StringLiteral
+	WithFill  *WithFillExpr
 }
 
 func (o *OrderByExpr) Pos() Pos {
@@ -2313,6 +5419,12 @@ func (o *OrderByExpr) Pos() Pos {
 }
 
 func (o *OrderByExpr) End() Pos {
+	if o.WithFill != nil {
+		return o.WithFill.End()
+	}
+	if o.Collate != nil {
+		return o.Collate.End()
+	}
 	return o.Expr.End()
 }
 
@@ -2323,6 +5435,18 @@ func (o *OrderByExpr) String(level int) string {
 		builder.WriteByte(' ')
 		builder.WriteString(string(o.Direction))
 	}
+	if o.Nulls != "" {
+		builder.WriteString(" NULLS ")
+		builder.WriteString(o.Nulls)
+	}
+	if o.Collate != nil {
+		builder.WriteString(" COLLATE ")
+		builder.WriteString(o.Collate.String(level))
+	}
+	if o.WithFill != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(o.WithFill.String(level))
+	}
 	return builder.String()
 }
 
@@ -2332,9 +5456,75 @@ func (o *OrderByExpr) Accept(visitor ASTVisitor) error {
 	if err := o.Expr.Accept(visitor); err != nil {
 		return err
 	}
+	if o.Collate != nil {
+		if err := o.Collate.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if o.WithFill != nil {
+		if err := o.WithFill.Accept(visitor); err != nil {
+			return err
+		}
+	}
 	return visitor.VisitOrderByExpr(o)
 }
 
+// WithFillExpr is the `WITH FILL [FROM expr] [TO expr] [STEP expr]` modifier of ORDER BY.
+type WithFillExpr struct {
+	WithPos Pos
+	FillEnd Pos
+	From    Expr
+	To      Expr
+	Step    Expr
+}
+
+func (w *WithFillExpr) Pos() Pos {
+	return w.WithPos
+}
+
+func (w *WithFillExpr) End() Pos {
+	return w.FillEnd
+}
+
+func (w *WithFillExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("WITH FILL")
+	if w.From != nil {
+		builder.WriteString(" FROM ")
+		builder.WriteString(w.From.String(level))
+	}
+	if w.To != nil {
+		builder.WriteString(" TO ")
+		builder.WriteString(w.To.String(level))
+	}
+	if w.Step != nil {
+		builder.WriteString(" STEP ")
+		builder.WriteString(w.Step.String(level))
+	}
+	return builder.String()
+}
+
+func (w *WithFillExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(w)
+	defer visitor.leave(w)
+	if w.From != nil {
+		if err := w.From.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if w.To != nil {
+		if err := w.To.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if w.Step != nil {
+		if err := w.Step.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitWithFillExpr(w)
+}
+
 type OrderByListExpr struct {
 	OrderPos Pos
 	ListEnd  Pos
@@ -2373,6 +5563,88 @@ func (o *OrderByListExpr) Accept(visitor ASTVisitor) error {
 	return visitor.VisitOrderByListExpr(o)
 }
 
+// InterpolateExpr is the `INTERPOLATE [(col [AS expr], ...)]` clause after ORDER BY ... WITH FILL.
+type InterpolateExpr struct {
+	InterpolatePos Pos
+	ListEnd        Pos
+	Items          []*InterpolateItem
+}
+
+func (i *InterpolateExpr) Pos() Pos {
+	return i.InterpolatePos
+}
+
+func (i *InterpolateExpr) End() Pos {
+	return i.ListEnd
+}
+
+func (i *InterpolateExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("INTERPOLATE")
+	if len(i.Items) > 0 {
+		builder.WriteString(" (")
+		for j, item := range i.Items {
+			if j > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(item.String(level))
+		}
+		builder.WriteByte(')')
+	}
+	return builder.String()
+}
+
+func (i *InterpolateExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(i)
+	defer visitor.leave(i)
+	for _, item := range i.Items {
+		if err := item.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitInterpolateExpr(i)
+}
+
+type InterpolateItem struct {
+	Column *Ident
+	Expr   Expr
+}
+
+func (i *InterpolateItem) Pos() Pos {
+	return i.Column.Pos()
+}
+
+func (i *InterpolateItem) End() Pos {
+	if i.Expr != nil {
+		return i.Expr.End()
+	}
+	return i.Column.End()
+}
+
+func (i *InterpolateItem) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(i.Column.String(level))
+	if i.Expr != nil {
+		builder.WriteString(" AS ")
+		builder.WriteString(i.Expr.String(level))
+	}
+	return builder.String()
+}
+
+func (i *InterpolateItem) Accept(visitor ASTVisitor) error {
+	visitor.enter(i)
+	defer visitor.leave(i)
+	if err := i.Column.Accept(visitor); err != nil {
+		return err
+	}
+	if i.Expr != nil {
+		if err := i.Expr.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitInterpolateItem(i)
+}
+
 type SettingsExpr struct {
 	SettingsPos Pos
 	Name        *Ident
@@ -2512,41 +5784,178 @@ func (a *ArrayParamList) Accept(visitor ASTVisitor) error {
 	return visitor.VisitArrayParamList(a)
 }
 
-type ObjectParams struct {
-	Object Expr
-	Params *ArrayParamList
+// IndexExpr is the subscript of arrays and maps, like arr[1] and m['key'].
+// KeyValue is the entry of MapLiteral.
+type KeyValue struct {
+	Key   Expr
+	Value Expr
+}
+
+// MapLiteral is the map like {'a': 1, 'b': 2}.
+type MapLiteral struct {
+	LBracePos Pos
+	RBracePos Pos
+	KeyValues []KeyValue
 }
 
-func (o *ObjectParams) Pos() Pos {
-	return o.Object.Pos()
+func (m *MapLiteral) Pos() Pos {
+	return m.LBracePos
 }
 
-func (o *ObjectParams) End() Pos {
-	return o.Params.End()
+func (m *MapLiteral) End() Pos {
+	return m.RBracePos
 }
 
-func (o *ObjectParams) String(level int) string {
+func (m *MapLiteral) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString(o.Object.String(level))
-	builder.WriteString(o.Params.String(level))
+	builder.WriteByte('{')
+	for i, kv := range m.KeyValues {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(kv.Key.String(level))
+		builder.WriteString(": ")
+		builder.WriteString(kv.Value.String(level))
+	}
+	builder.WriteByte('}')
 	return builder.String()
 }
 
-func (o *ObjectParams) Accept(visitor ASTVisitor) error {
-	visitor.enter(o)
-	defer visitor.leave(o)
-	if err := o.Object.Accept(visitor); err != nil {
+func (m *MapLiteral) Accept(visitor ASTVisitor) error {
+	visitor.enter(m)
+	defer visitor.leave(m)
+	for _, kv := range m.KeyValues {
+		if err := kv.Key.Accept(visitor); err != nil {
+			return err
+		}
+		if err := kv.Value.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitMapLiteral(m)
+}
+
+type IndexExpr struct {
+	Object   Expr
+	Index    Expr
+	IndexEnd Pos
+}
+
+func (i *IndexExpr) Pos() Pos {
+	return i.Object.Pos()
+}
+
+func (i *IndexExpr) End() Pos {
+	return i.IndexEnd
+}
+
+func (i *IndexExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(i.Object.String(level))
+	builder.WriteByte('[')
+	builder.WriteString(i.Index.String(level))
+	builder.WriteByte(']')
+	return builder.String()
+}
+
+func (i *IndexExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(i)
+	defer visitor.leave(i)
+	if err := i.Object.Accept(visitor); err != nil {
+		return err
+	}
+	if err := i.Index.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitIndexExpr(i)
+}
+
+// TupleElementExpr accesses the tuple element by the 1-based index, like t.1.
+type TupleElementExpr struct {
+	Tuple Expr
+	Index *NumberLiteral
+}
+
+func (t *TupleElementExpr) Pos() Pos {
+	return t.Tuple.Pos()
+}
+
+func (t *TupleElementExpr) End() Pos {
+	return t.Index.End()
+}
+
+func (t *TupleElementExpr) String(level int) string {
+	return t.Tuple.String(level) + "." + t.Index.String(level)
+}
+
+func (t *TupleElementExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(t)
+	defer visitor.leave(t)
+	if err := t.Tuple.Accept(visitor); err != nil {
+		return err
+	}
+	if err := t.Index.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitTupleElementExpr(t)
+}
+
+// SubcolumnPath accesses the subcolumns of JSON, Tuple or Nested columns, like
+// json.a.b.c and json.a.b.:Int64. The identifiers like col.size0 and json.a.b are
+// parsed as ColumnIdentifier since they can't be distinguished from table.column.
+type SubcolumnPath struct {
+	Object  Expr
+	Path    []*Ident
+	Type    Expr // the type hint after `.:`
+	PathEnd Pos
+}
+
+func (s *SubcolumnPath) Pos() Pos {
+	return s.Object.Pos()
+}
+
+func (s *SubcolumnPath) End() Pos {
+	return s.PathEnd
+}
+
+func (s *SubcolumnPath) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(s.Object.String(level))
+	for _, ident := range s.Path {
+		builder.WriteByte('.')
+		builder.WriteString(ident.String(level))
+	}
+	if s.Type != nil {
+		builder.WriteString(".:")
+		builder.WriteString(s.Type.String(level))
+	}
+	return builder.String()
+}
+
+func (s *SubcolumnPath) Accept(visitor ASTVisitor) error {
+	visitor.enter(s)
+	defer visitor.leave(s)
+	if err := s.Object.Accept(visitor); err != nil {
 		return err
 	}
-	if err := o.Params.Accept(visitor); err != nil {
-		return err
+	for _, ident := range s.Path {
+		if err := ident.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Type != nil {
+		if err := s.Type.Accept(visitor); err != nil {
+			return err
+		}
 	}
-	return visitor.VisitObjectParams(o)
+	return visitor.VisitSubcolumnPath(s)
 }
 
 type FunctionExpr struct {
 	Name   *Ident
 	Params *ParamExprList
+	Nulls  string // RESPECT or IGNORE
+	Filter *FilterExpr
 }
 
 func (f *FunctionExpr) Pos() Pos {
@@ -2554,6 +5963,9 @@ func (f *FunctionExpr) Pos() Pos {
 }
 
 func (f *FunctionExpr) End() Pos {
+	if f.Filter != nil {
+		return f.Filter.End()
+	}
 	return f.Params.RightParenPos
 }
 
@@ -2561,6 +5973,15 @@ func (f *FunctionExpr) String(level int) string {
 	var builder strings.Builder
 	builder.WriteString(f.Name.String(level))
 	builder.WriteString(f.Params.String(level))
+	if f.Nulls != "" {
+		builder.WriteByte(' ')
+		builder.WriteString(f.Nulls)
+		builder.WriteString(" NULLS")
+	}
+	if f.Filter != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(f.Filter.String(level))
+	}
 	return builder.String()
 }
 
@@ -2573,13 +5994,50 @@ func (f *FunctionExpr) Accept(visitor ASTVisitor) error {
 	if err := f.Params.Accept(visitor); err != nil {
 		return err
 	}
+	if f.Filter != nil {
+		if err := f.Filter.Accept(visitor); err != nil {
+			return err
+		}
+	}
 	return visitor.VisitFunctionExpr(f)
 }
 
+// FilterExpr is the `FILTER (WHERE cond)` clause of aggregate functions.
+type FilterExpr struct {
+	FilterPos     Pos
+	RightParenPos Pos
+	Condition     Expr
+}
+
+func (f *FilterExpr) Pos() Pos {
+	return f.FilterPos
+}
+
+func (f *FilterExpr) End() Pos {
+	return f.RightParenPos
+}
+
+func (f *FilterExpr) String(level int) string {
+	return "FILTER (WHERE " + f.Condition.String(level) + ")"
+}
+
+func (f *FilterExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(f)
+	defer visitor.leave(f)
+	if err := f.Condition.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitFilterExpr(f)
+}
+
 type WindowFunctionExpr struct {
 	Function *FunctionExpr
 	OverPos  Pos
-	OverExpr Expr
+	OverExpr Expr // the window name or *WindowConditionExpr
+
+	// Window is the named window definition referenced by OVER name,
+	// which is resolved after the query is parsed.
+	Window *WindowDefinition `json:"-"`
 }
 
 func (w *WindowFunctionExpr) Pos() Pos {
@@ -2621,7 +6079,7 @@ type Column struct {
 	Property Expr
 
 	Codec *CompressionCodec
-	TTL   Expr
+	TTL   *TTLExpr
 
 	Comment          *StringLiteral
 	CompressionCodec *Ident
@@ -2656,7 +6114,7 @@ func (c *Column) String(level int) string {
 		builder.WriteString(c.Codec.String(level))
 	}
 	if c.TTL != nil {
-		builder.WriteByte(' ')
+		builder.WriteString(" TTL ")
 		builder.WriteString(c.TTL.String(level))
 	}
 	if c.Comment != nil {
@@ -2966,7 +6424,8 @@ func (n *NumberLiteral) Accept(visitor ASTVisitor) error {
 type StringLiteral struct {
 	LiteralPos Pos
 	LiteralEnd Pos
-	Literal    string
+	Literal    string // the source text between the quotes
+	Value      string // the decoded value
 }
 
 func (s *StringLiteral) Pos() Pos {
@@ -2978,7 +6437,7 @@ func (s *StringLiteral) End() Pos {
 }
 
 func (s *StringLiteral) String(int) string {
-	return "'" + s.Literal + "'"
+	return "'" + EscapeString(s.Value) + "'"
 }
 
 func (s *StringLiteral) Accept(visitor ASTVisitor) error {
@@ -2987,6 +6446,45 @@ func (s *StringLiteral) Accept(visitor ASTVisitor) error {
 	return visitor.VisitStringLiteral(s)
 }
 
+// QueryParam is a query parameter placeholder like {name:Type},
+// the value is substituted by the server when the query is executed.
+type QueryParam struct {
+	LBracePos Pos
+	RBracePos Pos
+	Name      *Ident
+	Type      Expr
+}
+
+func (q *QueryParam) Pos() Pos {
+	return q.LBracePos
+}
+
+func (q *QueryParam) End() Pos {
+	return q.RBracePos
+}
+
+func (q *QueryParam) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("{")
+	builder.WriteString(q.Name.String(level))
+	builder.WriteString(":")
+	builder.WriteString(q.Type.String(level))
+	builder.WriteString("}")
+	return builder.String()
+}
+
+func (q *QueryParam) Accept(visitor ASTVisitor) error {
+	visitor.enter(q)
+	defer visitor.leave(q)
+	if err := q.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if err := q.Type.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitQueryParam(q)
+}
+
 type RatioExpr struct {
 	Numerator *NumberLiteral
 	// numberLiteral (SLASH numberLiteral)?
@@ -3298,7 +6796,9 @@ type ColumnExprList struct {
 	ListPos     Pos
 	ListEnd     Pos
 	HasDistinct bool
-	Items       []Expr
+	// DistinctOn is the column list of `DISTINCT ON (a, b)`, HasDistinct is also set.
+	DistinctOn *ColumnExprList
+	Items      []Expr
 }
 
 func (c *ColumnExprList) Pos() Pos {
@@ -3311,9 +6811,7 @@ func (c *ColumnExprList) End() Pos {
 
 func (c *ColumnExprList) String(level int) string {
 	var builder strings.Builder
-	if c.HasDistinct {
-		builder.WriteString("DISTINCT ")
-	}
+	builder.WriteString(c.distinctString(level))
 	for i, item := range c.Items {
 		builder.WriteString(item.String(level))
 		if i != len(c.Items)-1 {
@@ -3323,9 +6821,24 @@ func (c *ColumnExprList) String(level int) string {
 	return builder.String()
 }
 
+func (c *ColumnExprList) distinctString(level int) string {
+	switch {
+	case c.DistinctOn != nil:
+		return "DISTINCT ON (" + c.DistinctOn.String(level) + ") "
+	case c.HasDistinct:
+		return "DISTINCT "
+	}
+	return ""
+}
+
 func (c *ColumnExprList) Accept(visitor ASTVisitor) error {
 	visitor.enter(c)
 	defer visitor.leave(c)
+	if c.DistinctOn != nil {
+		if err := c.DistinctOn.Accept(visitor); err != nil {
+			return err
+		}
+	}
 	for _, item := range c.Items {
 		if err := item.Accept(visitor); err != nil {
 			return err
@@ -3334,6 +6847,234 @@ func (c *ColumnExprList) Accept(visitor ASTVisitor) error {
 	return visitor.VisitColumnExprList(c)
 }
 
+// ColumnsMatcher is the COLUMNS('regexp') or COLUMNS(a, b) matcher in the select list.
+type ColumnsMatcher struct {
+	ColumnsPos Pos
+	RParenPos  Pos
+	Pattern    *StringLiteral
+	Columns    *ColumnExprList
+}
+
+func (c *ColumnsMatcher) Pos() Pos {
+	return c.ColumnsPos
+}
+
+func (c *ColumnsMatcher) End() Pos {
+	return c.RParenPos + 1
+}
+
+func (c *ColumnsMatcher) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("COLUMNS(")
+	if c.Pattern != nil {
+		builder.WriteString(c.Pattern.String(level))
+	} else if c.Columns != nil {
+		builder.WriteString(c.Columns.String(level))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+func (c *ColumnsMatcher) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if c.Pattern != nil {
+		if err := c.Pattern.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Columns != nil {
+		if err := c.Columns.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitColumnsMatcher(c)
+}
+
+// ColumnTransformerExpr is the column matcher (*, t.* or COLUMNS(...)) followed by the
+// chained transformers, like `* EXCEPT (a) REPLACE (b + 1 AS b) APPLY(sum)`.
+type ColumnTransformerExpr struct {
+	Matcher Expr
+	// Transformers are the *ColumnExceptTransformer, *ColumnReplaceTransformer
+	// and *ColumnApplyTransformer in the order they appear.
+	Transformers []Expr
+}
+
+func (c *ColumnTransformerExpr) Pos() Pos {
+	return c.Matcher.Pos()
+}
+
+func (c *ColumnTransformerExpr) End() Pos {
+	if len(c.Transformers) > 0 {
+		return c.Transformers[len(c.Transformers)-1].End()
+	}
+	return c.Matcher.End()
+}
+
+func (c *ColumnTransformerExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(c.Matcher.String(level))
+	for _, transformer := range c.Transformers {
+		builder.WriteByte(' ')
+		builder.WriteString(transformer.String(level))
+	}
+	return builder.String()
+}
+
+func (c *ColumnTransformerExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if err := c.Matcher.Accept(visitor); err != nil {
+		return err
+	}
+	for _, transformer := range c.Transformers {
+		if err := transformer.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitColumnTransformerExpr(c)
+}
+
+// ColumnExceptTransformer is `EXCEPT [STRICT] (a, b)`, `EXCEPT a` or `EXCEPT('regexp')`.
+type ColumnExceptTransformer struct {
+	ExceptPos      Pos
+	TransformerEnd Pos
+	Strict         bool
+	HasParen       bool
+	// Columns are the *Ident to exclude, or a single *StringLiteral regexp.
+	Columns []Expr
+}
+
+func (c *ColumnExceptTransformer) Pos() Pos {
+	return c.ExceptPos
+}
+
+func (c *ColumnExceptTransformer) End() Pos {
+	return c.TransformerEnd
+}
+
+func (c *ColumnExceptTransformer) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("EXCEPT ")
+	if c.Strict {
+		builder.WriteString("STRICT ")
+	}
+	if c.HasParen {
+		builder.WriteByte('(')
+	}
+	for i, column := range c.Columns {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(column.String(level))
+	}
+	if c.HasParen {
+		builder.WriteByte(')')
+	}
+	return builder.String()
+}
+
+func (c *ColumnExceptTransformer) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	for _, column := range c.Columns {
+		if err := column.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitColumnExceptTransformer(c)
+}
+
+// ColumnReplaceTransformer is `REPLACE [STRICT] (expr AS name, ...)`.
+type ColumnReplaceTransformer struct {
+	ReplacePos     Pos
+	TransformerEnd Pos
+	Strict         bool
+	HasParen       bool
+	Replaces       []*AliasExpr
+}
+
+func (c *ColumnReplaceTransformer) Pos() Pos {
+	return c.ReplacePos
+}
+
+func (c *ColumnReplaceTransformer) End() Pos {
+	return c.TransformerEnd
+}
+
+func (c *ColumnReplaceTransformer) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("REPLACE ")
+	if c.Strict {
+		builder.WriteString("STRICT ")
+	}
+	if c.HasParen {
+		builder.WriteByte('(')
+	}
+	for i, replace := range c.Replaces {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(replace.String(level))
+	}
+	if c.HasParen {
+		builder.WriteByte(')')
+	}
+	return builder.String()
+}
+
+func (c *ColumnReplaceTransformer) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	for _, replace := range c.Replaces {
+		if err := replace.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitColumnReplaceTransformer(c)
+}
+
+// ColumnApplyTransformer is `APPLY(func)`, the Func is the function name, the
+// parametric function like quantile(0.9) or the lambda.
+type ColumnApplyTransformer struct {
+	ApplyPos       Pos
+	TransformerEnd Pos
+	HasParen       bool
+	Func           Expr
+}
+
+func (c *ColumnApplyTransformer) Pos() Pos {
+	return c.ApplyPos
+}
+
+func (c *ColumnApplyTransformer) End() Pos {
+	return c.TransformerEnd
+}
+
+func (c *ColumnApplyTransformer) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("APPLY")
+	if c.HasParen {
+		builder.WriteByte('(')
+	} else {
+		builder.WriteByte(' ')
+	}
+	builder.WriteString(c.Func.String(level))
+	if c.HasParen {
+		builder.WriteByte(')')
+	}
+	return builder.String()
+}
+
+func (c *ColumnApplyTransformer) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if err := c.Func.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitColumnApplyTransformer(c)
+}
+
 type WhenExpr struct {
 	WhenPos Pos
 	ThenPos Pos
@@ -3568,64 +7309,168 @@ type CreateLiveView struct {
 	SubQuery     *SubQueryExpr
 }
 
-func (c *CreateLiveView) Type() string {
-	return "LIVE_VIEW"
+func (c *CreateLiveView) Type() string {
+	return "LIVE_VIEW"
+}
+
+func (c *CreateLiveView) Pos() Pos {
+	return c.CreatePos
+}
+
+func (c *CreateLiveView) End() Pos {
+	return c.StatementEnd
+}
+
+func (c *CreateLiveView) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("CREATE LIVE VIEW ")
+	if c.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	builder.WriteString(c.Name.String(level))
+
+	if c.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.OnCluster.String(level))
+	}
+
+	if c.WithTimeout != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.WithTimeout.String(level))
+	}
+
+	if c.Destination != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.Destination.String(level))
+	}
+
+	if c.TableSchema != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.TableSchema.String(level))
+	}
+
+	if c.SubQuery != nil {
+		builder.WriteString(c.SubQuery.String(level))
+	}
+
+	return builder.String()
+}
+
+func (c *CreateLiveView) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if err := c.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if c.UUID != nil {
+		if err := c.UUID.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.OnCluster != nil {
+		if err := c.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Destination != nil {
+		if err := c.Destination.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.TableSchema != nil {
+		if err := c.TableSchema.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.WithTimeout != nil {
+		if err := c.WithTimeout.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.SubQuery != nil {
+		if err := c.SubQuery.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitCreateLiveView(c)
+}
+
+type CreateWindowView struct {
+	CreatePos       Pos
+	StatementEnd    Pos
+	Name            *TableIdentifier
+	IfNotExists     bool
+	OnCluster       *OnClusterExpr
+	Destination     *DestinationExpr
+	InnerEngine     *EngineExpr
+	Engine          *EngineExpr
+	Watermark       Expr // STRICTLY_ASCENDING, ASCENDING or the bounded interval
+	AllowedLateness Expr
+	Populate        bool
+	SubQuery        *SubQueryExpr
 }
 
-func (c *CreateLiveView) Pos() Pos {
+func (c *CreateWindowView) Type() string {
+	return "WINDOW_VIEW"
+}
+
+func (c *CreateWindowView) Pos() Pos {
 	return c.CreatePos
 }
 
-func (c *CreateLiveView) End() Pos {
+func (c *CreateWindowView) End() Pos {
 	return c.StatementEnd
 }
 
-func (c *CreateLiveView) String(level int) string {
+func (c *CreateWindowView) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("CREATE LIVE VIEW ")
+	builder.WriteString("CREATE WINDOW VIEW ")
 	if c.IfNotExists {
 		builder.WriteString("IF NOT EXISTS ")
 	}
 	builder.WriteString(c.Name.String(level))
-
 	if c.OnCluster != nil {
 		builder.WriteString(NewLine(level))
 		builder.WriteString(c.OnCluster.String(level))
 	}
-
-	if c.WithTimeout != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(c.WithTimeout.String(level))
-	}
-
 	if c.Destination != nil {
 		builder.WriteString(NewLine(level))
 		builder.WriteString(c.Destination.String(level))
 	}
-
-	if c.TableSchema != nil {
+	if c.InnerEngine != nil {
+		// the engine starts with a new line
 		builder.WriteString(NewLine(level))
-		builder.WriteString(c.TableSchema.String(level))
+		builder.WriteString("INNER ")
+		builder.WriteString(strings.TrimPrefix(c.InnerEngine.String(level), NewLine(level)))
+	}
+	if c.Engine != nil {
+		builder.WriteString(c.Engine.String(level))
+	}
+	if c.Watermark != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString("WATERMARK = ")
+		builder.WriteString(c.Watermark.String(level))
+	}
+	if c.AllowedLateness != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString("ALLOWED_LATENESS = ")
+		builder.WriteString(c.AllowedLateness.String(level))
+	}
+	if c.Populate {
+		builder.WriteString(" POPULATE")
 	}
-
 	if c.SubQuery != nil {
 		builder.WriteString(c.SubQuery.String(level))
 	}
-
 	return builder.String()
 }
 
-func (c *CreateLiveView) Accept(visitor ASTVisitor) error {
+func (c *CreateWindowView) Accept(visitor ASTVisitor) error {
 	visitor.enter(c)
 	defer visitor.leave(c)
 	if err := c.Name.Accept(visitor); err != nil {
 		return err
 	}
-	if c.UUID != nil {
-		if err := c.UUID.Accept(visitor); err != nil {
-			return err
-		}
-	}
 	if c.OnCluster != nil {
 		if err := c.OnCluster.Accept(visitor); err != nil {
 			return err
@@ -3636,13 +7481,23 @@ func (c *CreateLiveView) Accept(visitor ASTVisitor) error {
 			return err
 		}
 	}
-	if c.TableSchema != nil {
-		if err := c.TableSchema.Accept(visitor); err != nil {
+	if c.InnerEngine != nil {
+		if err := c.InnerEngine.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if c.WithTimeout != nil {
-		if err := c.WithTimeout.Accept(visitor); err != nil {
+	if c.Engine != nil {
+		if err := c.Engine.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.Watermark != nil {
+		if err := c.Watermark.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if c.AllowedLateness != nil {
+		if err := c.AllowedLateness.Accept(visitor); err != nil {
 			return err
 		}
 	}
@@ -3651,7 +7506,7 @@ func (c *CreateLiveView) Accept(visitor ASTVisitor) error {
 			return err
 		}
 	}
-	return visitor.VisitCreateLiveView(c)
+	return visitor.VisitCreateWindowView(c)
 }
 
 type WithTimeoutExpr struct {
@@ -3919,10 +7774,7 @@ func (f *FromExpr) String(level int) string {
 	isSubquery := false
 	joinTableExpr, ok := f.Expr.(*JoinTableExpr)
 	if ok && joinTableExpr.Table != nil {
-		_, ok := joinTableExpr.Table.Expr.(*SelectQuery)
-		if ok {
-			isSubquery = true
-		}
+		isSubquery = isQuery(joinTableExpr.Table.Expr)
 	}
 	if isSubquery {
 		builder.WriteString(" (")
@@ -4002,6 +7854,45 @@ func (n *IsNotNullExpr) Accept(visitor ASTVisitor) error {
 	return visitor.VisitIsNotNullExpr(n)
 }
 
+// IsDistinctFromExpr is `left IS [NOT] DISTINCT FROM right`, the NULL-safe comparison.
+type IsDistinctFromExpr struct {
+	LeftExpr  Expr
+	HasNot    bool
+	RightExpr Expr
+}
+
+func (d *IsDistinctFromExpr) Pos() Pos {
+	return d.LeftExpr.Pos()
+}
+
+func (d *IsDistinctFromExpr) End() Pos {
+	return d.RightExpr.End()
+}
+
+func (d *IsDistinctFromExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(d.LeftExpr.String(level))
+	builder.WriteString(" IS ")
+	if d.HasNot {
+		builder.WriteString("NOT ")
+	}
+	builder.WriteString("DISTINCT FROM ")
+	builder.WriteString(d.RightExpr.String(level))
+	return builder.String()
+}
+
+func (d *IsDistinctFromExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	if err := d.LeftExpr.Accept(visitor); err != nil {
+		return err
+	}
+	if err := d.RightExpr.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitIsDistinctFromExpr(d)
+}
+
 type AliasExpr struct {
 	Expr     Expr
 	AliasPos Pos
@@ -4018,7 +7909,7 @@ func (a *AliasExpr) End() Pos {
 
 func (a *AliasExpr) String(level int) string {
 	var builder strings.Builder
-	if _, isSelect := a.Expr.(*SelectQuery); isSelect {
+	if isQuery(a.Expr) {
 		builder.WriteByte('(')
 		builder.WriteString(a.Expr.String(level))
 		builder.WriteByte(')')
@@ -4100,8 +7991,10 @@ func (w *PrewhereExpr) Accept(visitor ASTVisitor) error {
 
 type GroupByExpr struct {
 	GroupByPos    Pos
-	AggregateType string
+	GroupByEnd    Pos
+	AggregateType string // CUBE, ROLLUP or GROUPING SETS
 	Expr          Expr
+	GroupByAll    bool
 	WithCube      bool
 	WithRollup    bool
 	WithTotals    bool
@@ -4112,19 +8005,20 @@ func (g *GroupByExpr) Pos() Pos {
 }
 
 func (g *GroupByExpr) End() Pos {
-	return g.Expr.End()
+	return g.GroupByEnd
 }
 
 func (g *GroupByExpr) String(level int) string {
 	var builder strings.Builder
 	builder.WriteString("GROUP BY ")
-	if g.AggregateType != "" {
-		builder.WriteString(g.AggregateType)
-		builder.WriteByte('(')
-	}
-	builder.WriteString(g.Expr.String(level))
-	if g.AggregateType != "" {
-		builder.WriteByte(')')
+	if g.GroupByAll {
+		builder.WriteString("ALL")
+	} else {
+		if g.AggregateType != "" {
+			// the expression of aggregate type is the parenthesized list
+			builder.WriteString(g.AggregateType)
+		}
+		builder.WriteString(g.Expr.String(level))
 	}
 	if g.WithCube {
 		builder.WriteString(" WITH CUBE")
@@ -4141,8 +8035,10 @@ func (g *GroupByExpr) String(level int) string {
 func (g *GroupByExpr) Accept(visitor ASTVisitor) error {
 	visitor.enter(g)
 	defer visitor.leave(g)
-	if err := g.Expr.Accept(visitor); err != nil {
-		return err
+	if g.Expr != nil {
+		if err := g.Expr.Accept(visitor); err != nil {
+			return err
+		}
 	}
 	return visitor.VisitGroupByExpr(g)
 }
@@ -4173,6 +8069,32 @@ func (h *HavingExpr) Accept(visitor ASTVisitor) error {
 	return visitor.VisitHavingExpr(h)
 }
 
+type QualifyExpr struct {
+	QualifyPos Pos
+	Expr       Expr
+}
+
+func (q *QualifyExpr) Pos() Pos {
+	return q.QualifyPos
+}
+
+func (q *QualifyExpr) End() Pos {
+	return q.Expr.End()
+}
+
+func (q *QualifyExpr) String(level int) string {
+	return "QUALIFY " + q.Expr.String(level)
+}
+
+func (q *QualifyExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(q)
+	defer visitor.leave(q)
+	if err := q.Expr.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitQualifyExpr(q)
+}
+
 type LimitExpr struct {
 	LimitPos Pos
 	Limit    Expr
@@ -4268,9 +8190,14 @@ func (l *LimitByExpr) Accept(visitor ASTVisitor) error {
 type WindowConditionExpr struct {
 	LeftParenPos  Pos
 	RightParenPos Pos
+	Name          *Ident // the existing window to extend
 	PartitionBy   *PartitionByExpr
 	OrderBy       *OrderByListExpr
 	Frame         *WindowFrameExpr
+
+	// Window is the named window definition referenced by Name,
+	// which is resolved after the query is parsed.
+	Window *WindowDefinition `json:"-"`
 }
 
 func (w *WindowConditionExpr) Pos() Pos {
@@ -4284,6 +8211,10 @@ func (w *WindowConditionExpr) End() Pos {
 func (w *WindowConditionExpr) String(level int) string {
 	var builder strings.Builder
 	builder.WriteByte('(')
+	if w.Name != nil {
+		builder.WriteString(NewLine(level + 1))
+		builder.WriteString(w.Name.String(level))
+	}
 	if w.PartitionBy != nil {
 		builder.WriteString(NewLine(level + 1))
 		builder.WriteString(w.PartitionBy.String(level))
@@ -4303,6 +8234,11 @@ func (w *WindowConditionExpr) String(level int) string {
 func (w *WindowConditionExpr) Accept(visitor ASTVisitor) error {
 	visitor.enter(w)
 	defer visitor.leave(w)
+	if w.Name != nil {
+		if err := w.Name.Accept(visitor); err != nil {
+			return err
+		}
+	}
 	if w.PartitionBy != nil {
 		if err := w.PartitionBy.Accept(visitor); err != nil {
 			return err
@@ -4321,12 +8257,10 @@ func (w *WindowConditionExpr) Accept(visitor ASTVisitor) error {
 	return visitor.VisitWindowConditionExpr(w)
 }
 
+// WindowExpr is the WINDOW clause which defines the named windows.
 type WindowExpr struct {
-	*WindowConditionExpr
-
-	WindowPos Pos
-	Name      *Ident
-	AsPos     Pos
+	WindowPos   Pos
+	Definitions []*WindowDefinition
 }
 
 func (w *WindowExpr) Pos() Pos {
@@ -4334,34 +8268,63 @@ func (w *WindowExpr) Pos() Pos {
 }
 
 func (w *WindowExpr) End() Pos {
-	return w.WindowConditionExpr.End()
+	return w.Definitions[len(w.Definitions)-1].End()
 }
 
 func (w *WindowExpr) String(level int) string {
 	var builder strings.Builder
 	builder.WriteString("WINDOW ")
-	builder.WriteString(w.Name.String(level))
-	builder.WriteString(" ")
-	builder.WriteString(w.WindowConditionExpr.String(level))
+	for i, definition := range w.Definitions {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(definition.String(level))
+	}
 	return builder.String()
 }
 
 func (w *WindowExpr) Accept(visitor ASTVisitor) error {
 	visitor.enter(w)
 	defer visitor.leave(w)
-	if w.WindowConditionExpr != nil {
-		if err := w.WindowConditionExpr.Accept(visitor); err != nil {
-			return err
-		}
-	}
-	if w.Name != nil {
-		if err := w.Name.Accept(visitor); err != nil {
+	for _, definition := range w.Definitions {
+		if err := definition.Accept(visitor); err != nil {
 			return err
 		}
 	}
 	return visitor.VisitWindowExpr(w)
 }
 
+// WindowDefinition is the `name AS (window condition)` in the WINDOW clause.
+type WindowDefinition struct {
+	Name      *Ident
+	AsPos     Pos
+	Condition *WindowConditionExpr
+}
+
+func (w *WindowDefinition) Pos() Pos {
+	return w.Name.Pos()
+}
+
+func (w *WindowDefinition) End() Pos {
+	return w.Condition.End()
+}
+
+func (w *WindowDefinition) String(level int) string {
+	return w.Name.String(level) + " AS " + w.Condition.String(level)
+}
+
+func (w *WindowDefinition) Accept(visitor ASTVisitor) error {
+	visitor.enter(w)
+	defer visitor.leave(w)
+	if err := w.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if err := w.Condition.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitWindowDefinition(w)
+}
+
 type WindowFrameExpr struct {
 	FramePos Pos
 	Type     string
@@ -4378,8 +8341,10 @@ func (f *WindowFrameExpr) End() Pos {
 
 func (f *WindowFrameExpr) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString(f.Type)
-	builder.WriteString(" ")
+	if f.Type != "" {
+		builder.WriteString(f.Type)
+		builder.WriteString(" ")
+	}
 	builder.WriteString(f.Extend.String(level))
 	return builder.String()
 }
@@ -4492,7 +8457,7 @@ func (f *WindowFrameUnbounded) End() Pos {
 }
 
 func (f *WindowFrameUnbounded) String(int) string {
-	return f.Direction + " UNBOUNDED"
+	return "UNBOUNDED " + f.Direction
 }
 
 func (f *WindowFrameUnbounded) Accept(visitor ASTVisitor) error {
@@ -4567,19 +8532,20 @@ type SelectQuery struct {
 	SelectColumns *ColumnExprList
 	From          *FromExpr
 	ArrayJoin     *ArrayJoinExpr
-	Window        *WindowExpr
 	Prewhere      *PrewhereExpr
 	Where         *WhereExpr
 	GroupBy       *GroupByExpr
 	WithTotal     bool
 	Having        *HavingExpr
+	Window        *WindowExpr
+	Qualify       *QualifyExpr
 	OrderBy       *OrderByListExpr
+	Interpolate   *InterpolateExpr
 	LimitBy       *LimitByExpr
 	Limit         *LimitExpr
 	Settings      *SettingsExprList
-	UnionAll      *SelectQuery
-	UnionDistinct *SelectQuery
-	Except        *SelectQuery
+	IntoOutfile   *IntoOutfileExpr
+	Format        *FormatExpr
 }
 
 func (s *SelectQuery) Pos() Pos {
@@ -4587,6 +8553,12 @@ func (s *SelectQuery) Pos() Pos {
 }
 
 func (s *SelectQuery) End() Pos {
+	if s.Format != nil {
+		return s.Format.End()
+	}
+	if s.IntoOutfile != nil {
+		return s.IntoOutfile.End()
+	}
 	return s.StatementEnd
 }
 
@@ -4609,9 +8581,7 @@ func (s *SelectQuery) String(level int) string { // nolint: funlen
 		builder.WriteString(s.Top.String(level))
 		builder.WriteString(" ")
 	}
-	if s.SelectColumns.HasDistinct {
-		builder.WriteString("DISTINCT ")
-	}
+	builder.WriteString(s.SelectColumns.distinctString(level))
 	columns := s.SelectColumns.Items
 	for i, column := range columns {
 		builder.WriteString(NewLine(level + 1))
@@ -4628,10 +8598,6 @@ func (s *SelectQuery) String(level int) string { // nolint: funlen
 		builder.WriteString(NewLine(level))
 		builder.WriteString(s.ArrayJoin.String(level))
 	}
-	if s.Window != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(s.Window.String(level))
-	}
 	if s.Prewhere != nil {
 		builder.WriteString(NewLine(level))
 		builder.WriteString(s.Prewhere.String(level))
@@ -4648,10 +8614,22 @@ func (s *SelectQuery) String(level int) string { // nolint: funlen
 		builder.WriteString(NewLine(level))
 		builder.WriteString(s.Having.String(level))
 	}
+	if s.Window != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(s.Window.String(level))
+	}
+	if s.Qualify != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(s.Qualify.String(level))
+	}
 	if s.OrderBy != nil {
 		builder.WriteString(NewLine(level))
 		builder.WriteString(s.OrderBy.String(level))
 	}
+	if s.Interpolate != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(s.Interpolate.String(level))
+	}
 	if s.LimitBy != nil {
 		builder.WriteString(NewLine(level))
 		builder.WriteString(s.LimitBy.String(level))
@@ -4664,18 +8642,13 @@ func (s *SelectQuery) String(level int) string { // nolint: funlen
 		builder.WriteString(NewLine(level))
 		builder.WriteString(s.Settings.String(level))
 	}
-	if s.UnionAll != nil {
+	if s.IntoOutfile != nil {
 		builder.WriteString(NewLine(level))
-		builder.WriteString(" UNION ALL ")
-		builder.WriteString(s.UnionAll.String(level))
-	} else if s.UnionDistinct != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(" UNION DISTINCT ")
-		builder.WriteString(s.UnionDistinct.String(level))
-	} else if s.Except != nil {
+		builder.WriteString(s.IntoOutfile.String(level))
+	}
+	if s.Format != nil {
 		builder.WriteString(NewLine(level))
-		builder.WriteString(" EXCEPT ")
-		builder.WriteString(s.Except.String(level))
+		builder.WriteString(s.Format.String(level))
 	}
 	return builder.String()
 }
@@ -4708,11 +8681,6 @@ func (s *SelectQuery) Accept(visitor ASTVisitor) error {
 			return err
 		}
 	}
-	if s.Window != nil {
-		if err := s.Window.Accept(visitor); err != nil {
-			return err
-		}
-	}
 	if s.Prewhere != nil {
 		if err := s.Prewhere.Accept(visitor); err != nil {
 			return err
@@ -4728,21 +8696,146 @@ func (s *SelectQuery) Accept(visitor ASTVisitor) error {
 			return err
 		}
 	}
-	if s.Having != nil {
-		if err := s.Having.Accept(visitor); err != nil {
-			return err
-		}
+	if s.Having != nil {
+		if err := s.Having.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Window != nil {
+		if err := s.Window.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Qualify != nil {
+		if err := s.Qualify.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.OrderBy != nil {
+		if err := s.OrderBy.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Interpolate != nil {
+		if err := s.Interpolate.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.LimitBy != nil {
+		if err := s.LimitBy.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Limit != nil {
+		if err := s.Limit.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Settings != nil {
+		if err := s.Settings.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.IntoOutfile != nil {
+		if err := s.IntoOutfile.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Format != nil {
+		if err := s.Format.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitSelectQuery(s)
+}
+
+// SetOperationExpr is the UNION, INTERSECT or EXCEPT of two queries. The operands are
+// *SelectQuery, *SetOperationExpr or *SelectParenExpr, and INTERSECT binds tighter than
+// UNION and EXCEPT, so `a UNION b INTERSECT c` is `a UNION (b INTERSECT c)`.
+type SetOperationExpr struct {
+	Left        Expr
+	OperatorPos Pos
+	Operator    string // UNION, INTERSECT or EXCEPT
+	Modifier    string // ALL, DISTINCT or empty
+	Right       Expr
+	// OrderBy, Limit and Settings apply to the whole result, they can only follow
+	// the parenthesized last operand.
+	OrderBy     *OrderByListExpr
+	Limit       *LimitExpr
+	Settings    *SettingsExprList
+	IntoOutfile *IntoOutfileExpr
+	Format      *FormatExpr
+}
+
+func (s *SetOperationExpr) Pos() Pos {
+	return s.Left.Pos()
+}
+
+func (s *SetOperationExpr) End() Pos {
+	switch {
+	case s.Format != nil:
+		return s.Format.End()
+	case s.IntoOutfile != nil:
+		return s.IntoOutfile.End()
+	case s.Settings != nil:
+		return s.Settings.End()
+	case s.Limit != nil:
+		return s.Limit.End()
+	case s.OrderBy != nil:
+		return s.OrderBy.End()
+	}
+	return s.Right.End()
+}
+
+func (s *SetOperationExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(s.Left.String(level))
+	builder.WriteString(NewLine(level))
+	builder.WriteString(" ")
+	builder.WriteString(s.Operator)
+	if s.Modifier != "" {
+		builder.WriteString(" ")
+		builder.WriteString(s.Modifier)
+	}
+	builder.WriteString(" ")
+	builder.WriteString(s.Right.String(level))
+	if s.OrderBy != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(s.OrderBy.String(level))
+	}
+	if s.Limit != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(s.Limit.String(level))
+	}
+	if s.Settings != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(s.Settings.String(level))
+	}
+	if s.IntoOutfile != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(s.IntoOutfile.String(level))
+	}
+	if s.Format != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(s.Format.String(level))
+	}
+	return builder.String()
+}
+
+func (s *SetOperationExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(s)
+	defer visitor.leave(s)
+	if err := s.Left.Accept(visitor); err != nil {
+		return err
+	}
+	if err := s.Right.Accept(visitor); err != nil {
+		return err
 	}
 	if s.OrderBy != nil {
 		if err := s.OrderBy.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if s.LimitBy != nil {
-		if err := s.LimitBy.Accept(visitor); err != nil {
-			return err
-		}
-	}
 	if s.Limit != nil {
 		if err := s.Limit.Accept(visitor); err != nil {
 			return err
@@ -4753,27 +8846,66 @@ func (s *SelectQuery) Accept(visitor ASTVisitor) error {
 			return err
 		}
 	}
-	if s.UnionAll != nil {
-		if err := s.UnionAll.Accept(visitor); err != nil {
+	if s.IntoOutfile != nil {
+		if err := s.IntoOutfile.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if s.UnionDistinct != nil {
-		if err := s.UnionDistinct.Accept(visitor); err != nil {
+	if s.Format != nil {
+		if err := s.Format.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	if s.Except != nil {
-		if err := s.Except.Accept(visitor); err != nil {
-			return err
-		}
+	return visitor.VisitSetOperationExpr(s)
+}
+
+// SelectParenExpr is the parenthesized operand of the set operation,
+// like `(SELECT ... LIMIT 1)` in `SELECT ... UNION ALL (SELECT ... LIMIT 1)`.
+type SelectParenExpr struct {
+	LeftParenPos  Pos
+	RightParenPos Pos
+	Query         Expr
+}
+
+func (s *SelectParenExpr) Pos() Pos {
+	return s.LeftParenPos
+}
+
+func (s *SelectParenExpr) End() Pos {
+	return s.RightParenPos + 1
+}
+
+func (s *SelectParenExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("(")
+	builder.WriteString(s.Query.String(level + 1))
+	builder.WriteString(NewLine(level))
+	builder.WriteString(")")
+	return builder.String()
+}
+
+func (s *SelectParenExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(s)
+	defer visitor.leave(s)
+	if err := s.Query.Accept(visitor); err != nil {
+		return err
 	}
-	return visitor.VisitSelectQuery(s)
+	return visitor.VisitSelectParenExpr(s)
+}
+
+// isQuery reports whether the expr is the SELECT query or the set operation of queries,
+// which need the brackets when used as the subquery.
+func isQuery(expr Expr) bool {
+	switch expr.(type) {
+	case *SelectQuery, *SetOperationExpr:
+		return true
+	}
+	return false
 }
 
 type SubQueryExpr struct {
 	AsPos  Pos
-	Select *SelectQuery
+	Select Expr
 }
 
 func (s *SubQueryExpr) Pos() Pos {
@@ -4919,9 +9051,12 @@ func (e *ExtractExpr) Accept(visitor ASTVisitor) error {
 type DropDatabase struct {
 	DropPos      Pos
 	StatementEnd Pos
+	IsDetach     bool
 	Name         *Ident
 	IfExists     bool
 	OnCluster    *OnClusterExpr
+	Permanently  bool
+	Modifier     string
 }
 
 func (d *DropDatabase) Pos() Pos {
@@ -4938,7 +9073,11 @@ func (d *DropDatabase) Type() string {
 
 func (d *DropDatabase) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("DROP DATABASE ")
+	if d.IsDetach {
+		builder.WriteString("DETACH DATABASE ")
+	} else {
+		builder.WriteString("DROP DATABASE ")
+	}
 	if d.IfExists {
 		builder.WriteString("IF EXISTS ")
 	}
@@ -4947,6 +9086,12 @@ func (d *DropDatabase) String(level int) string {
 		builder.WriteString(NewLine(level + 1))
 		builder.WriteString(d.OnCluster.String(level))
 	}
+	if d.Permanently {
+		builder.WriteString(" PERMANENTLY")
+	}
+	if len(d.Modifier) != 0 {
+		builder.WriteString(" " + d.Modifier)
+	}
 	return builder.String()
 }
 
@@ -4968,33 +9113,333 @@ type DropStmt struct {
 	DropPos      Pos
 	StatementEnd Pos
 
+	IsDetach    bool
 	DropTarget  string
-	Name        *TableIdentifier
+	Names       []*TableIdentifier
 	IfExists    bool
+	IfEmpty     bool
 	OnCluster   *OnClusterExpr
 	IsTemporary bool
+	Permanently bool
 	Modifier    string
 }
 
-func (d *DropStmt) Pos() Pos {
+func (d *DropStmt) Pos() Pos {
+	return d.DropPos
+}
+
+func (d *DropStmt) End() Pos {
+	return d.StatementEnd
+}
+
+func (d *DropStmt) Type() string {
+	if d.IsDetach {
+		return "DETACH " + d.DropTarget
+	}
+	return "DROP " + d.DropTarget
+}
+
+func (d *DropStmt) String(level int) string {
+	var builder strings.Builder
+	if d.IsDetach {
+		builder.WriteString("DETACH ")
+	} else {
+		builder.WriteString("DROP ")
+	}
+	if d.IsTemporary {
+		builder.WriteString("TEMPORARY ")
+	}
+	builder.WriteString(d.DropTarget + " ")
+	if d.IfExists {
+		builder.WriteString("IF EXISTS ")
+	}
+	if d.IfEmpty {
+		builder.WriteString("IF EMPTY ")
+	}
+	for i, name := range d.Names {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(name.String(level))
+	}
+	if d.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(d.OnCluster.String(level))
+	}
+	if d.Permanently {
+		builder.WriteString(" PERMANENTLY")
+	}
+	if len(d.Modifier) != 0 {
+		builder.WriteString(" " + d.Modifier)
+	}
+	return builder.String()
+}
+
+func (d *DropStmt) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	for _, name := range d.Names {
+		if err := name.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if d.OnCluster != nil {
+		if err := d.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitDropStmt(d)
+}
+
+type UndropStmt struct {
+	UndropPos    Pos
+	StatementEnd Pos
+
+	Name      *TableIdentifier
+	UUID      *UUID
+	OnCluster *OnClusterExpr
+}
+
+func (u *UndropStmt) Pos() Pos {
+	return u.UndropPos
+}
+
+func (u *UndropStmt) End() Pos {
+	return u.StatementEnd
+}
+
+func (u *UndropStmt) Type() string {
+	return "UNDROP TABLE"
+}
+
+func (u *UndropStmt) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("UNDROP TABLE ")
+	builder.WriteString(u.Name.String(level))
+	if u.UUID != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(u.UUID.String(level))
+	}
+	if u.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(u.OnCluster.String(level))
+	}
+	return builder.String()
+}
+
+func (u *UndropStmt) Accept(visitor ASTVisitor) error {
+	visitor.enter(u)
+	defer visitor.leave(u)
+	if err := u.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if u.UUID != nil {
+		if err := u.UUID.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if u.OnCluster != nil {
+		if err := u.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitUndropStmt(u)
+}
+
+type NamedCollectionParam struct {
+	Setting     *SettingsExpr
+	ParamEnd    Pos
+	Overridable string // OVERRIDABLE or NOT OVERRIDABLE, empty if not specified
+}
+
+func (n *NamedCollectionParam) Pos() Pos {
+	return n.Setting.Pos()
+}
+
+func (n *NamedCollectionParam) End() Pos {
+	return n.ParamEnd
+}
+
+func (n *NamedCollectionParam) String(level int) string {
+	if len(n.Overridable) == 0 {
+		return n.Setting.String(level)
+	}
+	return n.Setting.String(level) + " " + n.Overridable
+}
+
+func (n *NamedCollectionParam) Accept(visitor ASTVisitor) error {
+	visitor.enter(n)
+	defer visitor.leave(n)
+	if err := n.Setting.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitNamedCollectionParam(n)
+}
+
+func writeNamedCollectionParams(builder *strings.Builder, params []*NamedCollectionParam, level int) {
+	for i, param := range params {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(param.String(level))
+	}
+}
+
+type CreateNamedCollection struct {
+	CreatePos    Pos
+	StatementEnd Pos
+
+	IfNotExists bool
+	Name        *Ident
+	OnCluster   *OnClusterExpr
+	Params      []*NamedCollectionParam
+}
+
+func (c *CreateNamedCollection) Pos() Pos {
+	return c.CreatePos
+}
+
+func (c *CreateNamedCollection) End() Pos {
+	return c.StatementEnd
+}
+
+func (c *CreateNamedCollection) Type() string {
+	return "NAMED COLLECTION"
+}
+
+func (c *CreateNamedCollection) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("CREATE NAMED COLLECTION ")
+	if c.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	builder.WriteString(c.Name.String(level))
+	if c.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.OnCluster.String(level))
+	}
+	builder.WriteString(" AS ")
+	writeNamedCollectionParams(&builder, c.Params, level)
+	return builder.String()
+}
+
+func (c *CreateNamedCollection) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if err := c.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if c.OnCluster != nil {
+		if err := c.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, param := range c.Params {
+		if err := param.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitCreateNamedCollection(c)
+}
+
+type AlterNamedCollection struct {
+	AlterPos     Pos
+	StatementEnd Pos
+
+	IfExists  bool
+	Name      *Ident
+	OnCluster *OnClusterExpr
+	Set       []*NamedCollectionParam
+	Delete    []*Ident
+}
+
+func (a *AlterNamedCollection) Pos() Pos {
+	return a.AlterPos
+}
+
+func (a *AlterNamedCollection) End() Pos {
+	return a.StatementEnd
+}
+
+func (a *AlterNamedCollection) Type() string {
+	return "NAMED COLLECTION"
+}
+
+func (a *AlterNamedCollection) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("ALTER NAMED COLLECTION ")
+	if a.IfExists {
+		builder.WriteString("IF EXISTS ")
+	}
+	builder.WriteString(a.Name.String(level))
+	if a.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(a.OnCluster.String(level))
+	}
+	if len(a.Set) > 0 {
+		builder.WriteString(" SET ")
+		writeNamedCollectionParams(&builder, a.Set, level)
+	}
+	if len(a.Delete) > 0 {
+		builder.WriteString(" DELETE ")
+		for i, key := range a.Delete {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(key.String(level))
+		}
+	}
+	return builder.String()
+}
+
+func (a *AlterNamedCollection) Accept(visitor ASTVisitor) error {
+	visitor.enter(a)
+	defer visitor.leave(a)
+	if err := a.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if a.OnCluster != nil {
+		if err := a.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, param := range a.Set {
+		if err := param.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, key := range a.Delete {
+		if err := key.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitAlterNamedCollection(a)
+}
+
+type DropNamedCollection struct {
+	DropPos      Pos
+	StatementEnd Pos
+
+	IfExists  bool
+	Name      *Ident
+	OnCluster *OnClusterExpr
+}
+
+func (d *DropNamedCollection) Pos() Pos {
 	return d.DropPos
 }
 
-func (d *DropStmt) End() Pos {
+func (d *DropNamedCollection) End() Pos {
 	return d.StatementEnd
 }
 
-func (d *DropStmt) Type() string {
-	return "DROP " + d.DropTarget
+func (d *DropNamedCollection) Type() string {
+	return "NAMED COLLECTION"
 }
 
-func (d *DropStmt) String(level int) string {
+func (d *DropNamedCollection) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("DROP ")
-	if d.IsTemporary {
-		builder.WriteString("TEMPORARY ")
-	}
-	builder.WriteString(d.DropTarget + " ")
+	builder.WriteString("DROP NAMED COLLECTION ")
 	if d.IfExists {
 		builder.WriteString("IF EXISTS ")
 	}
@@ -5003,13 +9448,10 @@ func (d *DropStmt) String(level int) string {
 		builder.WriteString(NewLine(level))
 		builder.WriteString(d.OnCluster.String(level))
 	}
-	if len(d.Modifier) != 0 {
-		builder.WriteString(" " + d.Modifier)
-	}
 	return builder.String()
 }
 
-func (d *DropStmt) Accept(visitor ASTVisitor) error {
+func (d *DropNamedCollection) Accept(visitor ASTVisitor) error {
 	visitor.enter(d)
 	defer visitor.leave(d)
 	if err := d.Name.Accept(visitor); err != nil {
@@ -5020,8 +9462,7 @@ func (d *DropStmt) Accept(visitor ASTVisitor) error {
 			return err
 		}
 	}
-	return visitor.VisitDropStmt(d)
-
+	return visitor.VisitDropNamedCollection(d)
 }
 
 type DropUserOrRole struct {
@@ -5029,7 +9470,9 @@ type DropUserOrRole struct {
 	Target       string
 	StatementEnd Pos
 	Names        []*RoleName
+	Policies     []*RowPolicyName
 	IfExists     bool
+	OnCluster    *OnClusterExpr
 	Modifier     string
 	From         *Ident
 }
@@ -5058,6 +9501,16 @@ func (d *DropUserOrRole) String(level int) string {
 		}
 		builder.WriteString(name.String(level))
 	}
+	for i, policy := range d.Policies {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(policy.String(level))
+	}
+	if d.OnCluster != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(d.OnCluster.String(level))
+	}
 	if len(d.Modifier) != 0 {
 		builder.WriteString(" " + d.Modifier)
 	}
@@ -5076,6 +9529,16 @@ func (d *DropUserOrRole) Accept(visitor ASTVisitor) error {
 			return err
 		}
 	}
+	for _, policy := range d.Policies {
+		if err := policy.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if d.OnCluster != nil {
+		if err := d.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
 	if d.From != nil {
 		if err := d.From.Accept(visitor); err != nil {
 			return err
@@ -5129,7 +9592,7 @@ func (c *CTEExpr) String(level int) string {
 	var builder strings.Builder
 	builder.WriteString(c.Expr.String(level))
 	builder.WriteString(" AS ")
-	if _, isSelect := c.Alias.(*SelectQuery); isSelect {
+	if isQuery(c.Alias) {
 		builder.WriteByte('(')
 		builder.WriteString(c.Alias.String(level + 2))
 		builder.WriteByte(')')
@@ -5185,6 +9648,67 @@ func (s *SetExpr) Accept(visitor ASTVisitor) error {
 	return visitor.VisitSetExpr(s)
 }
 
+// IntoOutfileExpr is the INTO OUTFILE clause which writes the query result to a file,
+// like INTO OUTFILE 'out.csv.gz' AND STDOUT TRUNCATE COMPRESSION 'gzip' LEVEL 6.
+type IntoOutfileExpr struct {
+	IntoPos     Pos
+	IntoEnd     Pos
+	Filename    *StringLiteral
+	AndStdout   bool
+	Mode        string // APPEND or TRUNCATE
+	Compression *StringLiteral
+	Level       *NumberLiteral
+}
+
+func (i *IntoOutfileExpr) Pos() Pos {
+	return i.IntoPos
+}
+
+func (i *IntoOutfileExpr) End() Pos {
+	return i.IntoEnd
+}
+
+func (i *IntoOutfileExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("INTO OUTFILE ")
+	builder.WriteString(i.Filename.String(level))
+	if i.AndStdout {
+		builder.WriteString(" AND STDOUT")
+	}
+	if i.Mode != "" {
+		builder.WriteByte(' ')
+		builder.WriteString(i.Mode)
+	}
+	if i.Compression != nil {
+		builder.WriteString(" COMPRESSION ")
+		builder.WriteString(i.Compression.String(level))
+		if i.Level != nil {
+			builder.WriteString(" LEVEL ")
+			builder.WriteString(i.Level.String(level))
+		}
+	}
+	return builder.String()
+}
+
+func (i *IntoOutfileExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(i)
+	defer visitor.leave(i)
+	if err := i.Filename.Accept(visitor); err != nil {
+		return err
+	}
+	if i.Compression != nil {
+		if err := i.Compression.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if i.Level != nil {
+		if err := i.Level.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitIntoOutfileExpr(i)
+}
+
 type FormatExpr struct {
 	FormatPos Pos
 	Format    *Ident
@@ -5219,6 +9743,7 @@ type OptimizeExpr struct {
 	Partition    *PartitionExpr
 	HasFinal     bool
 	Deduplicate  *DeduplicateExpr
+	Format       *FormatExpr
 }
 
 func (o *OptimizeExpr) Pos() Pos {
@@ -5226,6 +9751,9 @@ func (o *OptimizeExpr) Pos() Pos {
 }
 
 func (o *OptimizeExpr) End() Pos {
+	if o.Format != nil {
+		return o.Format.End()
+	}
 	return o.StatementEnd
 }
 
@@ -5247,6 +9775,10 @@ func (o *OptimizeExpr) String(level int) string {
 	if o.Deduplicate != nil {
 		builder.WriteString(o.Deduplicate.String(level))
 	}
+	if o.Format != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(o.Format.String(level))
+	}
 	return builder.String()
 }
 
@@ -5271,6 +9803,11 @@ func (o *OptimizeExpr) Accept(visitor ASTVisitor) error {
 			return err
 		}
 	}
+	if o.Format != nil {
+		if err := o.Format.Accept(visitor); err != nil {
+			return err
+		}
+	}
 	return visitor.VisitOptimizeExpr(o)
 }
 
@@ -5323,9 +9860,78 @@ func (d *DeduplicateExpr) Accept(visitor ASTVisitor) error {
 	return visitor.VisitDeduplicateExpr(d)
 }
 
+type SystemCommandKind string
+
+const (
+	SystemFlushLogs                   SystemCommandKind = "FLUSH LOGS"
+	SystemFlushDistributed            SystemCommandKind = "FLUSH DISTRIBUTED"
+	SystemReloadDictionaries          SystemCommandKind = "RELOAD DICTIONARIES"
+	SystemReloadDictionary            SystemCommandKind = "RELOAD DICTIONARY"
+	SystemReloadEmbeddedDictionaries  SystemCommandKind = "RELOAD EMBEDDED DICTIONARIES"
+	SystemReloadConfig                SystemCommandKind = "RELOAD CONFIG"
+	SystemReloadUsers                 SystemCommandKind = "RELOAD USERS"
+	SystemReloadFunctions             SystemCommandKind = "RELOAD FUNCTIONS"
+	SystemReloadFunction              SystemCommandKind = "RELOAD FUNCTION"
+	SystemReloadAsynchronousMetrics   SystemCommandKind = "RELOAD ASYNCHRONOUS METRICS"
+	SystemDropDNSCache                SystemCommandKind = "DROP DNS CACHE"
+	SystemDropMarkCache               SystemCommandKind = "DROP MARK CACHE"
+	SystemDropUncompressedCache       SystemCommandKind = "DROP UNCOMPRESSED CACHE"
+	SystemDropCompiledExpressionCache SystemCommandKind = "DROP COMPILED EXPRESSION CACHE"
+	SystemDropQueryCache              SystemCommandKind = "DROP QUERY CACHE"
+	SystemDropFilesystemCache         SystemCommandKind = "DROP FILESYSTEM CACHE"
+	SystemDropMMapCache               SystemCommandKind = "DROP MMAP CACHE"
+	SystemDropSchemaCache             SystemCommandKind = "DROP SCHEMA CACHE"
+	SystemDropFormatSchemaCache       SystemCommandKind = "DROP FORMAT SCHEMA CACHE"
+	SystemDropReplica                 SystemCommandKind = "DROP REPLICA"
+	SystemDropDatabaseReplica         SystemCommandKind = "DROP DATABASE REPLICA"
+	SystemStartMerges                 SystemCommandKind = "START MERGES"
+	SystemStopMerges                  SystemCommandKind = "STOP MERGES"
+	SystemStartTTLMerges              SystemCommandKind = "START TTL MERGES"
+	SystemStopTTLMerges               SystemCommandKind = "STOP TTL MERGES"
+	SystemStartMoves                  SystemCommandKind = "START MOVES"
+	SystemStopMoves                   SystemCommandKind = "STOP MOVES"
+	SystemStartFetches                SystemCommandKind = "START FETCHES"
+	SystemStopFetches                 SystemCommandKind = "STOP FETCHES"
+	SystemStartReplicatedSends        SystemCommandKind = "START REPLICATED SENDS"
+	SystemStopReplicatedSends         SystemCommandKind = "STOP REPLICATED SENDS"
+	SystemStartDistributedSends       SystemCommandKind = "START DISTRIBUTED SENDS"
+	SystemStopDistributedSends        SystemCommandKind = "STOP DISTRIBUTED SENDS"
+	SystemStartReplicationQueues      SystemCommandKind = "START REPLICATION QUEUES"
+	SystemStopReplicationQueues       SystemCommandKind = "STOP REPLICATION QUEUES"
+	SystemStartViews                  SystemCommandKind = "START VIEWS"
+	SystemStopViews                   SystemCommandKind = "STOP VIEWS"
+	SystemStartView                   SystemCommandKind = "START VIEW"
+	SystemStopView                    SystemCommandKind = "STOP VIEW"
+	SystemRefreshView                 SystemCommandKind = "REFRESH VIEW"
+	SystemCancelView                  SystemCommandKind = "CANCEL VIEW"
+	SystemWaitView                    SystemCommandKind = "WAIT VIEW"
+	SystemSyncReplica                 SystemCommandKind = "SYNC REPLICA"
+	SystemSyncDatabaseReplica         SystemCommandKind = "SYNC DATABASE REPLICA"
+	SystemSyncTransactionLog          SystemCommandKind = "SYNC TRANSACTION LOG"
+	SystemSyncFileCache               SystemCommandKind = "SYNC FILE CACHE"
+	SystemRestartReplica              SystemCommandKind = "RESTART REPLICA"
+	SystemRestartReplicas             SystemCommandKind = "RESTART REPLICAS"
+	SystemRestoreReplica              SystemCommandKind = "RESTORE REPLICA"
+	SystemWaitLoadingParts            SystemCommandKind = "WAIT LOADING PARTS"
+	SystemUnfreeze                    SystemCommandKind = "UNFREEZE WITH NAME"
+	SystemShutdown                    SystemCommandKind = "SHUTDOWN"
+	SystemKill                        SystemCommandKind = "KILL"
+)
+
 type SystemExpr struct {
-	SystemPos Pos
-	Expr      Expr
+	SystemPos     Pos
+	StatementEnd  Pos
+	Kind          SystemCommandKind
+	OnCluster     *OnClusterExpr
+	Name          *StringLiteral   // replica name of DROP REPLICA or backup name of UNFREEZE
+	From          string           // TABLE, DATABASE or ZKPATH of DROP REPLICA
+	Table         *TableIdentifier // table, dictionary or view the command applies to
+	Database      *Ident
+	ZooKeeperPath *StringLiteral
+	Policy        *Ident // storage policy of START/STOP MERGES ON VOLUME policy.volume
+	Volume        *Ident
+	Modifier      string // STRICT, LIGHTWEIGHT or PULL of SYNC REPLICA
+	Format        *FormatExpr
 }
 
 func (s *SystemExpr) Pos() Pos {
@@ -5333,524 +9939,973 @@ func (s *SystemExpr) Pos() Pos {
 }
 
 func (s *SystemExpr) End() Pos {
-	return s.Expr.End()
+	if s.Format != nil {
+		return s.Format.End()
+	}
+	return s.StatementEnd
 }
 
 func (s *SystemExpr) String(level int) string {
-	return "SYSTEM " + s.Expr.String(level)
+	var builder strings.Builder
+	builder.WriteString("SYSTEM ")
+	builder.WriteString(string(s.Kind))
+	if s.OnCluster != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(s.OnCluster.String(level))
+	}
+	if s.Name != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(s.Name.String(level))
+	}
+	if s.From != "" {
+		builder.WriteString(" FROM ")
+		builder.WriteString(s.From)
+	}
+	switch {
+	case s.Table != nil:
+		builder.WriteByte(' ')
+		builder.WriteString(s.Table.String(level))
+	case s.Database != nil:
+		builder.WriteByte(' ')
+		builder.WriteString(s.Database.String(level))
+	case s.ZooKeeperPath != nil:
+		builder.WriteByte(' ')
+		builder.WriteString(s.ZooKeeperPath.String(level))
+	case s.Volume != nil:
+		builder.WriteString(" ON VOLUME ")
+		builder.WriteString(s.Policy.String(level))
+		builder.WriteByte('.')
+		builder.WriteString(s.Volume.String(level))
+	}
+	if s.Modifier != "" {
+		builder.WriteByte(' ')
+		builder.WriteString(s.Modifier)
+	}
+	if s.Format != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(s.Format.String(level))
+	}
+	return builder.String()
 }
 
 func (s *SystemExpr) Accept(visitor ASTVisitor) error {
 	visitor.enter(s)
 	defer visitor.leave(s)
-	if err := s.Expr.Accept(visitor); err != nil {
-		return err
+	if s.OnCluster != nil {
+		if err := s.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Name != nil {
+		if err := s.Name.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Table != nil {
+		if err := s.Table.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Database != nil {
+		if err := s.Database.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.ZooKeeperPath != nil {
+		if err := s.ZooKeeperPath.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Policy != nil {
+		if err := s.Policy.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Volume != nil {
+		if err := s.Volume.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Format != nil {
+		if err := s.Format.Accept(visitor); err != nil {
+			return err
+		}
 	}
 	return visitor.VisitSystemExpr(s)
 }
 
-type SystemFlushExpr struct {
-	FlushPos     Pos
+type TruncateTable struct {
+	TruncatePos  Pos
 	StatementEnd Pos
-	Logs         bool
-	Distributed  *TableIdentifier
+	IsTemporary  bool
+	IfExists     bool
+	Name         *TableIdentifier
+	OnCluster    *OnClusterExpr
+}
+
+func (t *TruncateTable) Pos() Pos {
+	return t.TruncatePos
 }
 
-func (s *SystemFlushExpr) Pos() Pos {
-	return s.FlushPos
+func (t *TruncateTable) End() Pos {
+	return t.StatementEnd
 }
 
-func (s *SystemFlushExpr) End() Pos {
-	return s.StatementEnd
+func (t *TruncateTable) Type() string {
+	return "TRUNCATE TABLE"
 }
 
-func (s *SystemFlushExpr) String(level int) string {
+func (t *TruncateTable) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("FLUSH ")
-	if s.Logs {
-		builder.WriteString("LOGS")
-	} else {
-		builder.WriteString(s.Distributed.String(level))
+	builder.WriteString("TRUNCATE ")
+	if t.IsTemporary {
+		builder.WriteString("TEMPORARY ")
+	}
+	builder.WriteString("TABLE ")
+	if t.IfExists {
+		builder.WriteString("IF EXISTS ")
+	}
+	builder.WriteString(t.Name.String(level))
+	if t.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(t.OnCluster.String(level))
+	}
+	return builder.String()
+}
+
+func (t *TruncateTable) Accept(visitor ASTVisitor) error {
+	visitor.enter(t)
+	defer visitor.leave(t)
+	if err := t.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if t.OnCluster != nil {
+		if err := t.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitTruncateTable(t)
+}
+
+type SampleRatioExpr struct {
+	SamplePos Pos
+	Ratio     *RatioExpr
+	Offset    *RatioExpr
+}
+
+func (s *SampleRatioExpr) Pos() Pos {
+	return s.SamplePos
+}
+
+func (s *SampleRatioExpr) End() Pos {
+	if s.Offset != nil {
+		return s.Offset.End()
+	}
+	return s.Ratio.End()
+}
+
+func (s *SampleRatioExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("SAMPLE ")
+	builder.WriteString(s.Ratio.String(level))
+	if s.Offset != nil {
+		builder.WriteString(" OFFSET ")
+		builder.WriteString(s.Offset.String(level))
 	}
 	return builder.String()
 }
 
-func (s *SystemFlushExpr) Accept(visitor ASTVisitor) error {
+func (s *SampleRatioExpr) Accept(visitor ASTVisitor) error {
 	visitor.enter(s)
 	defer visitor.leave(s)
-	if s.Distributed != nil {
-		if err := s.Distributed.Accept(visitor); err != nil {
+	if err := s.Ratio.Accept(visitor); err != nil {
+		return err
+	}
+	if s.Offset != nil {
+		if err := s.Offset.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitSampleRatioExpr(s)
+}
+
+type DeleteFromExpr struct {
+	DeletePos   Pos
+	Table       *TableIdentifier
+	OnCluster   *OnClusterExpr
+	InPartition *PartitionExpr
+	WhereExpr   Expr
+	Settings    *SettingsExprList
+}
+
+func (d *DeleteFromExpr) Pos() Pos {
+	return d.DeletePos
+}
+
+func (d *DeleteFromExpr) End() Pos {
+	if d.Settings != nil {
+		return d.Settings.End()
+	}
+	return d.WhereExpr.End()
+}
+
+func (d *DeleteFromExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("DELETE FROM ")
+	builder.WriteString(d.Table.String(level))
+	if d.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(d.OnCluster.String(level))
+	}
+	if d.InPartition != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString("IN ")
+		builder.WriteString(d.InPartition.String(level))
+	}
+	if d.WhereExpr != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString("WHERE ")
+		builder.WriteString(d.WhereExpr.String(level))
+	}
+	if d.Settings != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(d.Settings.String(level))
+	}
+	return builder.String()
+}
+
+func (d *DeleteFromExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	if err := d.Table.Accept(visitor); err != nil {
+		return err
+	}
+	if d.OnCluster != nil {
+		if err := d.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if d.InPartition != nil {
+		if err := d.InPartition.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if d.WhereExpr != nil {
+		if err := d.WhereExpr.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if d.Settings != nil {
+		if err := d.Settings.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitSystemFlushExpr(s)
+	return visitor.VisitDeleteFromExpr(d)
 }
 
-type SystemReloadExpr struct {
-	ReloadPos    Pos
-	StatementEnd Pos
-	Dictionary   *TableIdentifier
-	Type         string
+type UpdateExpr struct {
+	UpdatePos   Pos
+	Table       *TableIdentifier
+	OnCluster   *OnClusterExpr
+	Assignments []*UpdateAssignment
+	InPartition *PartitionExpr
+	WhereExpr   Expr
 }
 
-func (s *SystemReloadExpr) Pos() Pos {
-	return s.ReloadPos
+func (u *UpdateExpr) Pos() Pos {
+	return u.UpdatePos
 }
 
-func (s *SystemReloadExpr) End() Pos {
-	return s.StatementEnd
+func (u *UpdateExpr) End() Pos {
+	return u.WhereExpr.End()
 }
 
-func (s *SystemReloadExpr) String(level int) string {
+func (u *UpdateExpr) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("RELOAD ")
-	builder.WriteString(s.Type)
-	if s.Dictionary != nil {
-		builder.WriteByte(' ')
-		builder.WriteString(s.Dictionary.String(level))
+	builder.WriteString("UPDATE ")
+	builder.WriteString(u.Table.String(level))
+	if u.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(u.OnCluster.String(level))
+	}
+	builder.WriteString(NewLine(level))
+	builder.WriteString("SET ")
+	for i, assignment := range u.Assignments {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(assignment.String(level))
 	}
+	if u.InPartition != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString("IN ")
+		builder.WriteString(u.InPartition.String(level))
+	}
+	builder.WriteString(NewLine(level))
+	builder.WriteString("WHERE ")
+	builder.WriteString(u.WhereExpr.String(level))
 	return builder.String()
 }
 
-func (s *SystemReloadExpr) Accept(visitor ASTVisitor) error {
-	visitor.enter(s)
-	defer visitor.leave(s)
-	if s.Dictionary != nil {
-		if err := s.Dictionary.Accept(visitor); err != nil {
+func (u *UpdateExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(u)
+	defer visitor.leave(u)
+	if err := u.Table.Accept(visitor); err != nil {
+		return err
+	}
+	if u.OnCluster != nil {
+		if err := u.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, assignment := range u.Assignments {
+		if err := assignment.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if u.InPartition != nil {
+		if err := u.InPartition.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitSystemReloadExpr(s)
+	if err := u.WhereExpr.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitUpdateExpr(u)
 }
 
-type SystemSyncExpr struct {
-	SyncPos Pos
-	Cluster *TableIdentifier
+type ColumnNamesExpr struct {
+	LeftParenPos  Pos
+	RightParenPos Pos
+	ColumnNames   []NestedIdentifier
 }
 
-func (s *SystemSyncExpr) Pos() Pos {
-	return s.SyncPos
+func (c *ColumnNamesExpr) Pos() Pos {
+	return c.LeftParenPos
 }
 
-func (s *SystemSyncExpr) End() Pos {
-	return s.Cluster.End()
+func (c *ColumnNamesExpr) End() Pos {
+	return c.RightParenPos
 }
 
-func (s *SystemSyncExpr) String(level int) string {
+func (c *ColumnNamesExpr) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("SYNC ")
-	builder.WriteString(s.Cluster.String(level))
+	builder.WriteByte('(')
+	for i, column := range c.ColumnNames {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(column.String(level))
+	}
+	builder.WriteByte(')')
 	return builder.String()
 }
 
-func (s *SystemSyncExpr) Accept(visitor ASTVisitor) error {
-	visitor.enter(s)
-	defer visitor.leave(s)
-	if err := s.Cluster.Accept(visitor); err != nil {
-		return err
+func (c *ColumnNamesExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	for i := range c.ColumnNames {
+		if err := c.ColumnNames[i].Accept(visitor); err != nil {
+			return err
+		}
 	}
-	return visitor.VisitSystemSyncExpr(s)
+	return visitor.VisitColumnNamesExpr(c)
 }
 
-type SystemCtrlExpr struct {
-	CtrlPos      Pos
-	StatementEnd Pos
-	Command      string // START, STOP
-	Type         string // REPLICATED, DISTRIBUTED
-	Cluster      *TableIdentifier
+type ValuesExpr struct {
+	LeftParenPos  Pos
+	RightParenPos Pos
+	Values        []Expr
 }
 
-func (s *SystemCtrlExpr) Pos() Pos {
-	return s.CtrlPos
+func (v *ValuesExpr) Pos() Pos {
+	return v.LeftParenPos
 }
 
-func (s *SystemCtrlExpr) End() Pos {
-	return s.StatementEnd
+func (v *ValuesExpr) End() Pos {
+	return v.RightParenPos
 }
 
-func (s *SystemCtrlExpr) String(level int) string {
+func (v *ValuesExpr) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString(s.Command)
-	builder.WriteByte(' ')
-	builder.WriteString(s.Type)
-	if s.Cluster != nil {
-		builder.WriteByte(' ')
-		builder.WriteString(s.Cluster.String(level))
+	builder.WriteByte('(')
+	for i, value := range v.Values {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(value.String(level))
 	}
+	builder.WriteByte(')')
 	return builder.String()
 }
 
-func (s *SystemCtrlExpr) Accept(visitor ASTVisitor) error {
-	visitor.enter(s)
-	defer visitor.leave(s)
-	if s.Cluster != nil {
-		if err := s.Cluster.Accept(visitor); err != nil {
+func (v *ValuesExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(v)
+	defer visitor.leave(v)
+	for _, value := range v.Values {
+		if err := value.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitSystemCtrlExpr(s)
-}
-
-type SystemDropExpr struct {
-	DropPos      Pos
-	StatementEnd Pos
-	Type         string
+	return visitor.VisitValuesExpr(v)
 }
 
-func (s *SystemDropExpr) Pos() Pos {
-	return s.DropPos
+type InsertExpr struct {
+	InsertPos   Pos
+	Format      *FormatExpr
+	HasFunction bool // INSERT INTO FUNCTION, the Table is *TableFunctionExpr
+	Table       Expr
+	ColumnNames *ColumnNamesExpr
+	Settings    *SettingsExprList
+	Values      []*ValuesExpr
+	SelectExpr  Expr
+	// DataPos and Data are the raw inline data after the FORMAT clause, which is
+	// kept as is up to the end of the input.
+	DataPos Pos
+	Data    string
 }
 
-func (s *SystemDropExpr) End() Pos {
-	return s.StatementEnd
+func (i *InsertExpr) Pos() Pos {
+	return i.InsertPos
 }
 
-func (s *SystemDropExpr) String(level int) string {
-	return "DROP " + s.Type
+func (i *InsertExpr) End() Pos {
+	switch {
+	case i.Data != "":
+		return i.DataPos + Pos(len(i.Data))
+	case i.Format != nil:
+		return i.Format.End()
+	case i.SelectExpr != nil:
+		return i.SelectExpr.End()
+	case len(i.Values) > 0:
+		return i.Values[len(i.Values)-1].End()
+	case i.Settings != nil:
+		return i.Settings.End()
+	case i.ColumnNames != nil:
+		return i.ColumnNames.End()
+	}
+	return i.Table.End()
 }
 
-func (s *SystemDropExpr) Accept(visitor ASTVisitor) error {
-	visitor.enter(s)
-	defer visitor.leave(s)
-	return visitor.VisitSystemDropExpr(s)
+func (i *InsertExpr) String(level int) string {
+	var builder strings.Builder
+	if i.HasFunction {
+		builder.WriteString("INSERT INTO FUNCTION ")
+	} else {
+		builder.WriteString("INSERT INTO TABLE ")
+	}
+	builder.WriteString(i.Table.String(level))
+	if i.ColumnNames != nil {
+		builder.WriteString(NewLine(level + 1))
+		builder.WriteString(i.ColumnNames.String(level))
+	}
+	if i.Settings != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(i.Settings.String(level))
+	}
+	switch {
+	case i.Format != nil:
+		builder.WriteString(NewLine(level))
+		builder.WriteString(i.Format.String(level))
+		if i.Data != "" {
+			builder.WriteByte('\n')
+			builder.WriteString(i.Data)
+		}
+	case i.SelectExpr != nil:
+		builder.WriteString(NewLine(level))
+		// the query starts with a new line unless it has a WITH clause
+		builder.WriteString(strings.TrimPrefix(i.SelectExpr.String(level), NewLine(level)))
+	default:
+		builder.WriteString(NewLine(level))
+		builder.WriteString("VALUES ")
+		for j, value := range i.Values {
+			if j > 0 {
+				builder.WriteByte(',')
+			}
+			builder.WriteString(NewLine(level + 1))
+			builder.WriteString(value.String(level))
+		}
+	}
+	return builder.String()
 }
 
-type TruncateTable struct {
-	TruncatePos  Pos
-	StatementEnd Pos
-	IsTemporary  bool
-	IfExists     bool
-	Name         *TableIdentifier
-	OnCluster    *OnClusterExpr
+func (i *InsertExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(i)
+	defer visitor.leave(i)
+	if i.Format != nil {
+		if err := i.Format.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if err := i.Table.Accept(visitor); err != nil {
+		return err
+	}
+	if i.ColumnNames != nil {
+		if err := i.ColumnNames.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if i.Settings != nil {
+		if err := i.Settings.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, value := range i.Values {
+		if err := value.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if i.SelectExpr != nil {
+		if err := i.SelectExpr.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitInsertExpr(i)
 }
 
-func (t *TruncateTable) Pos() Pos {
-	return t.TruncatePos
+type CheckExpr struct {
+	CheckPos  Pos
+	Table     *TableIdentifier
+	Partition *PartitionExpr
+	Format    *FormatExpr
 }
 
-func (t *TruncateTable) End() Pos {
-	return t.StatementEnd
+func (c *CheckExpr) Pos() Pos {
+	return c.CheckPos
 }
 
-func (t *TruncateTable) Type() string {
-	return "TRUNCATE TABLE"
+func (c *CheckExpr) End() Pos {
+	if c.Format != nil {
+		return c.Format.End()
+	}
+	if c.Partition != nil {
+		return c.Partition.End()
+	}
+	return c.Table.End()
 }
 
-func (t *TruncateTable) String(level int) string {
+func (c *CheckExpr) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("TRUNCATE ")
-	if t.IsTemporary {
-		builder.WriteString("TEMPORARY ")
-	}
-	builder.WriteString("TABLE ")
-	if t.IfExists {
-		builder.WriteString("IF EXISTS ")
+	builder.WriteString("CHECK TABLE ")
+	builder.WriteString(c.Table.String(level))
+	if c.Partition != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(c.Partition.String(level))
 	}
-	builder.WriteString(t.Name.String(level))
-	if t.OnCluster != nil {
+	if c.Format != nil {
 		builder.WriteString(NewLine(level))
-		builder.WriteString(t.OnCluster.String(level))
+		builder.WriteString(c.Format.String(level))
 	}
 	return builder.String()
 }
 
-func (t *TruncateTable) Accept(visitor ASTVisitor) error {
-	visitor.enter(t)
-	defer visitor.leave(t)
-	if err := t.Name.Accept(visitor); err != nil {
+func (c *CheckExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(c)
+	defer visitor.leave(c)
+	if err := c.Table.Accept(visitor); err != nil {
 		return err
 	}
-	if t.OnCluster != nil {
-		if err := t.OnCluster.Accept(visitor); err != nil {
+	if c.Partition != nil {
+		if err := c.Partition.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitTruncateTable(t)
+	if c.Format != nil {
+		if err := c.Format.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitCheckExpr(c)
 }
 
-type SampleRatioExpr struct {
-	SamplePos Pos
-	Ratio     *RatioExpr
-	Offset    *RatioExpr
+type ShowExpr struct {
+	ShowPos      Pos
+	StatementEnd Pos
+	Full         bool
+	Temporary    bool
+	Target       string           // TABLES, DATABASES, DICTIONARIES, COLUMNS or PROCESSLIST
+	Table        *TableIdentifier // table of SHOW COLUMNS
+	From         *Ident           // FROM|IN database
+	NotLike      bool
+	LikeKind     string // LIKE or ILIKE
+	Like         *StringLiteral
+	Where        *WhereExpr
+	Limit        *LimitExpr
+	Format       *FormatExpr
 }
 
-func (s *SampleRatioExpr) Pos() Pos {
-	return s.SamplePos
+func (s *ShowExpr) Pos() Pos {
+	return s.ShowPos
 }
 
-func (s *SampleRatioExpr) End() Pos {
-	if s.Offset != nil {
-		return s.Offset.End()
+func (s *ShowExpr) End() Pos {
+	if s.Format != nil {
+		return s.Format.End()
 	}
-	return s.Ratio.End()
+	return s.StatementEnd
 }
 
-func (s *SampleRatioExpr) String(level int) string {
+func (s *ShowExpr) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("SAMPLE ")
-	builder.WriteString(s.Ratio.String(level))
-	if s.Offset != nil {
-		builder.WriteString(" OFFSET ")
-		builder.WriteString(s.Offset.String(level))
+	builder.WriteString("SHOW ")
+	if s.Full {
+		builder.WriteString("FULL ")
+	}
+	if s.Temporary {
+		builder.WriteString("TEMPORARY ")
+	}
+	builder.WriteString(s.Target)
+	if s.Table != nil {
+		builder.WriteString(" FROM ")
+		builder.WriteString(s.Table.String(level))
+	}
+	if s.From != nil {
+		builder.WriteString(" FROM ")
+		builder.WriteString(s.From.String(level))
+	}
+	if s.Like != nil {
+		if s.NotLike {
+			builder.WriteString(" NOT")
+		}
+		builder.WriteString(" " + s.LikeKind + " ")
+		builder.WriteString(s.Like.String(level))
+	}
+	if s.Where != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(s.Where.String(level))
+	}
+	if s.Limit != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(s.Limit.String(level))
+	}
+	if s.Format != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(s.Format.String(level))
 	}
 	return builder.String()
 }
 
-func (s *SampleRatioExpr) Accept(visitor ASTVisitor) error {
+func (s *ShowExpr) Accept(visitor ASTVisitor) error {
 	visitor.enter(s)
 	defer visitor.leave(s)
-	if err := s.Ratio.Accept(visitor); err != nil {
-		return err
+	if s.Table != nil {
+		if err := s.Table.Accept(visitor); err != nil {
+			return err
+		}
 	}
-	if s.Offset != nil {
-		if err := s.Offset.Accept(visitor); err != nil {
+	if s.From != nil {
+		if err := s.From.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitSampleRatioExpr(s)
+	if s.Like != nil {
+		if err := s.Like.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Where != nil {
+		if err := s.Where.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Limit != nil {
+		if err := s.Limit.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if s.Format != nil {
+		if err := s.Format.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitShowExpr(s)
 }
 
-type DeleteFromExpr struct {
-	DeletePos Pos
-	Table     *TableIdentifier
-	OnCluster *OnClusterExpr
-	WhereExpr Expr
+type ShowCreateExpr struct {
+	ShowPos   Pos
+	Temporary bool
+	Target    string // TABLE, DICTIONARY, VIEW, DATABASE or empty
+	Name      *TableIdentifier
+	Format    *FormatExpr
 }
 
-func (d *DeleteFromExpr) Pos() Pos {
-	return d.DeletePos
+func (s *ShowCreateExpr) Pos() Pos {
+	return s.ShowPos
 }
 
-func (d *DeleteFromExpr) End() Pos {
-	return d.WhereExpr.End()
+func (s *ShowCreateExpr) End() Pos {
+	if s.Format != nil {
+		return s.Format.End()
+	}
+	return s.Name.End()
 }
 
-func (d *DeleteFromExpr) String(level int) string {
+func (s *ShowCreateExpr) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("DELETE FROM ")
-	builder.WriteString(d.Table.String(level))
-	if d.OnCluster != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(d.OnCluster.String(level))
+	builder.WriteString("SHOW CREATE ")
+	if s.Temporary {
+		builder.WriteString("TEMPORARY ")
 	}
-	if d.WhereExpr != nil {
+	if s.Target != "" {
+		builder.WriteString(s.Target + " ")
+	}
+	builder.WriteString(s.Name.String(level))
+	if s.Format != nil {
 		builder.WriteString(NewLine(level))
-		builder.WriteString("WHERE ")
-		builder.WriteString(d.WhereExpr.String(level))
+		builder.WriteString(s.Format.String(level))
 	}
 	return builder.String()
 }
 
-func (d *DeleteFromExpr) Accept(visitor ASTVisitor) error {
-	visitor.enter(d)
-	defer visitor.leave(d)
-	if err := d.Table.Accept(visitor); err != nil {
+func (s *ShowCreateExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(s)
+	defer visitor.leave(s)
+	if err := s.Name.Accept(visitor); err != nil {
 		return err
 	}
-	if d.OnCluster != nil {
-		if err := d.OnCluster.Accept(visitor); err != nil {
-			return err
-		}
-	}
-	if d.WhereExpr != nil {
-		if err := d.WhereExpr.Accept(visitor); err != nil {
+	if s.Format != nil {
+		if err := s.Format.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitDeleteFromExpr(d)
+	return visitor.VisitShowCreateExpr(s)
 }
 
-type ColumnNamesExpr struct {
-	LeftParenPos  Pos
-	RightParenPos Pos
-	ColumnNames   []NestedIdentifier
+type ShowGrantsExpr struct {
+	ShowPos      Pos
+	StatementEnd Pos
+	For          []*RoleName
+	WithImplicit bool
+	Final        bool
+	Format       *FormatExpr
 }
 
-func (c *ColumnNamesExpr) Pos() Pos {
-	return c.LeftParenPos
+func (s *ShowGrantsExpr) Pos() Pos {
+	return s.ShowPos
 }
 
-func (c *ColumnNamesExpr) End() Pos {
-	return c.RightParenPos
+func (s *ShowGrantsExpr) End() Pos {
+	if s.Format != nil {
+		return s.Format.End()
+	}
+	return s.StatementEnd
 }
 
-func (c *ColumnNamesExpr) String(level int) string {
+func (s *ShowGrantsExpr) String(level int) string {
 	var builder strings.Builder
-	builder.WriteByte('(')
-	for i, column := range c.ColumnNames {
-		if i > 0 {
-			builder.WriteString(", ")
+	builder.WriteString("SHOW GRANTS")
+	if len(s.For) > 0 {
+		builder.WriteString(" FOR ")
+		for i, name := range s.For {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(name.String(level))
 		}
-		builder.WriteString(column.String(level))
 	}
-	builder.WriteByte(')')
+	if s.WithImplicit {
+		builder.WriteString(" WITH IMPLICIT")
+	}
+	if s.Final {
+		builder.WriteString(" FINAL")
+	}
+	if s.Format != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(s.Format.String(level))
+	}
 	return builder.String()
 }
 
-func (c *ColumnNamesExpr) Accept(visitor ASTVisitor) error {
-	visitor.enter(c)
-	defer visitor.leave(c)
-	for i := range c.ColumnNames {
-		if err := c.ColumnNames[i].Accept(visitor); err != nil {
+func (s *ShowGrantsExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(s)
+	defer visitor.leave(s)
+	for _, name := range s.For {
+		if err := name.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitColumnNamesExpr(c)
+	if s.Format != nil {
+		if err := s.Format.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitShowGrantsExpr(s)
 }
 
-type ValuesExpr struct {
-	LeftParenPos  Pos
-	RightParenPos Pos
-	Values        []Expr
+type DescribeExpr struct {
+	DescribePos Pos
+	HasTable    bool
+	Table       *TableExpr
+	Settings    *SettingsExprList
+	Format      *FormatExpr
 }
 
-func (v *ValuesExpr) Pos() Pos {
-	return v.LeftParenPos
+func (d *DescribeExpr) Pos() Pos {
+	return d.DescribePos
 }
 
-func (v *ValuesExpr) End() Pos {
-	return v.RightParenPos
+func (d *DescribeExpr) End() Pos {
+	if d.Format != nil {
+		return d.Format.End()
+	}
+	if d.Settings != nil {
+		return d.Settings.End()
+	}
+	return d.Table.End()
 }
 
-func (v *ValuesExpr) String(level int) string {
+func (d *DescribeExpr) String(level int) string {
 	var builder strings.Builder
-	builder.WriteByte('(')
-	for i, value := range v.Values {
-		if i > 0 {
-			builder.WriteString(", ")
-		}
-		builder.WriteString(value.String(level))
+	builder.WriteString("DESCRIBE ")
+	if d.HasTable {
+		builder.WriteString("TABLE ")
+	}
+	if isQuery(d.Table.Expr) {
+		builder.WriteByte('(')
+		builder.WriteString(d.Table.String(level))
+		builder.WriteByte(')')
+	} else {
+		builder.WriteString(d.Table.String(level))
+	}
+	if d.Settings != nil {
+		builder.WriteString(" ")
+		builder.WriteString(d.Settings.String(level))
+	}
+	if d.Format != nil {
+		builder.WriteString(" ")
+		builder.WriteString(d.Format.String(level))
 	}
-	builder.WriteByte(')')
 	return builder.String()
 }
 
-func (v *ValuesExpr) Accept(visitor ASTVisitor) error {
-	visitor.enter(v)
-	defer visitor.leave(v)
-	for _, value := range v.Values {
-		if err := value.Accept(visitor); err != nil {
+func (d *DescribeExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(d)
+	defer visitor.leave(d)
+	if err := d.Table.Accept(visitor); err != nil {
+		return err
+	}
+	if d.Settings != nil {
+		if err := d.Settings.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitValuesExpr(v)
+	if d.Format != nil {
+		if err := d.Format.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitDescribeExpr(d)
 }
 
-type InsertExpr struct {
-	InsertPos   Pos
-	Format      *FormatExpr
-	Table       Expr
-	ColumnNames *ColumnNamesExpr
-	Values      []*ValuesExpr
-	SelectExpr  *SelectQuery
+type ExistsExpr struct {
+	ExistsPos Pos
+	Temporary bool
+	Target    string // TABLE, DICTIONARY, VIEW, DATABASE or empty
+	Name      *TableIdentifier
+	Format    *FormatExpr
 }
 
-func (i *InsertExpr) Pos() Pos {
-	return i.InsertPos
+func (e *ExistsExpr) Pos() Pos {
+	return e.ExistsPos
 }
 
-func (i *InsertExpr) End() Pos {
-	if i.SelectExpr != nil {
-		return i.SelectExpr.End()
+func (e *ExistsExpr) End() Pos {
+	if e.Format != nil {
+		return e.Format.End()
 	}
-	return i.Values[len(i.Values)-1].End()
+	return e.Name.End()
 }
 
-func (i *InsertExpr) String(level int) string {
+func (e *ExistsExpr) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("INSERT INTO TABLE ")
-	builder.WriteString(i.Table.String(level))
-	if i.ColumnNames != nil {
-		builder.WriteString(NewLine(level + 1))
-		builder.WriteString(i.ColumnNames.String(level))
+	builder.WriteString("EXISTS ")
+	if e.Temporary {
+		builder.WriteString("TEMPORARY ")
 	}
-	if i.Format != nil {
-		builder.WriteString(NewLine(level))
-		builder.WriteString(i.Format.String(level))
+	if e.Target != "" {
+		builder.WriteString(e.Target + " ")
 	}
-
-	if i.SelectExpr != nil {
-		builder.WriteString(i.SelectExpr.String(level))
-	} else {
-		builder.WriteString(NewLine(level))
-		builder.WriteString("VALUES ")
-		for j, value := range i.Values {
-			if j > 0 {
-				builder.WriteByte(',')
-			}
-			builder.WriteString(NewLine(level + 1))
-			builder.WriteString(value.String(level))
-		}
+	builder.WriteString(e.Name.String(level))
+	if e.Format != nil {
+		builder.WriteString(" ")
+		builder.WriteString(e.Format.String(level))
 	}
 	return builder.String()
 }
 
-func (i *InsertExpr) Accept(visitor ASTVisitor) error {
-	visitor.enter(i)
-	defer visitor.leave(i)
-	if i.Format != nil {
-		if err := i.Format.Accept(visitor); err != nil {
-			return err
-		}
-	}
-	if err := i.Table.Accept(visitor); err != nil {
+func (e *ExistsExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(e)
+	defer visitor.leave(e)
+	if err := e.Name.Accept(visitor); err != nil {
 		return err
 	}
-	if i.ColumnNames != nil {
-		if err := i.ColumnNames.Accept(visitor); err != nil {
-			return err
-		}
-	}
-	for _, value := range i.Values {
-		if err := value.Accept(visitor); err != nil {
-			return err
-		}
-	}
-	if i.SelectExpr != nil {
-		if err := i.SelectExpr.Accept(visitor); err != nil {
+	if e.Format != nil {
+		if err := e.Format.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitInsertExpr(i)
+	return visitor.VisitExistsExpr(e)
 }
 
-type CheckExpr struct {
-	CheckPos  Pos
-	Table     *TableIdentifier
-	Partition *PartitionExpr
+type KillExpr struct {
+	KillPos      Pos
+	StatementEnd Pos
+	Target       string // QUERY or MUTATION
+	OnCluster    *OnClusterExpr
+	Where        *WhereExpr
+	Modifier     string // SYNC, ASYNC or TEST
+	Format       *FormatExpr
 }
 
-func (c *CheckExpr) Pos() Pos {
-	return c.CheckPos
+func (k *KillExpr) Pos() Pos {
+	return k.KillPos
 }
 
-func (c *CheckExpr) End() Pos {
-	return c.Partition.End()
+func (k *KillExpr) End() Pos {
+	if k.Format != nil {
+		return k.Format.End()
+	}
+	return k.StatementEnd
 }
 
-func (c *CheckExpr) String(level int) string {
+func (k *KillExpr) String(level int) string {
 	var builder strings.Builder
-	builder.WriteString("CHECK TABLE ")
-	builder.WriteString(c.Table.String(level))
+	builder.WriteString("KILL ")
+	builder.WriteString(k.Target)
+	if k.OnCluster != nil {
+		builder.WriteString(" ")
+		builder.WriteString(k.OnCluster.String(level))
+	}
 	builder.WriteString(NewLine(level))
-	if c.Partition != nil {
-		builder.WriteString(c.Partition.String(level))
+	builder.WriteString(k.Where.String(level))
+	if k.Modifier != "" {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(k.Modifier)
+	}
+	if k.Format != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(k.Format.String(level))
 	}
 	return builder.String()
 }
 
-func (c *CheckExpr) Accept(visitor ASTVisitor) error {
-	visitor.enter(c)
-	defer visitor.leave(c)
-	if err := c.Table.Accept(visitor); err != nil {
+func (k *KillExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(k)
+	defer visitor.leave(k)
+	if k.OnCluster != nil {
+		if err := k.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if err := k.Where.Accept(visitor); err != nil {
 		return err
 	}
-	if c.Partition != nil {
-		if err := c.Partition.Accept(visitor); err != nil {
+	if k.Format != nil {
+		if err := k.Format.Accept(visitor); err != nil {
 			return err
 		}
 	}
-	return visitor.VisitCheckExpr(c)
+	return visitor.VisitKillExpr(k)
 }
 
 type UnaryExpr struct {
@@ -5955,10 +11010,77 @@ func (t *TargetPair) String() string {
 	return t.Old.String(0) + " TO " + t.New.String(0)
 }
 
+type ExchangeStmt struct {
+	ExchangePos  Pos
+	StatementEnd Pos
+
+	ExchangeTarget string
+	Left           *TableIdentifier
+	Right          *TableIdentifier
+	OnCluster      *OnClusterExpr
+}
+
+func (e *ExchangeStmt) Pos() Pos {
+	return e.ExchangePos
+}
+
+func (e *ExchangeStmt) End() Pos {
+	return e.StatementEnd
+}
+
+func (e *ExchangeStmt) Type() string {
+	return "EXCHANGE " + e.ExchangeTarget
+}
+
+func (e *ExchangeStmt) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("EXCHANGE " + e.ExchangeTarget + " ")
+	builder.WriteString(e.Left.String(level))
+	builder.WriteString(" AND ")
+	builder.WriteString(e.Right.String(level))
+	if e.OnCluster != nil {
+		builder.WriteString(NewLine(level))
+		builder.WriteString(e.OnCluster.String(level))
+	}
+	return builder.String()
+}
+
+func (e *ExchangeStmt) Accept(visitor ASTVisitor) error {
+	visitor.enter(e)
+	defer visitor.leave(e)
+	if err := e.Left.Accept(visitor); err != nil {
+		return err
+	}
+	if err := e.Right.Accept(visitor); err != nil {
+		return err
+	}
+	if e.OnCluster != nil {
+		if err := e.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitExchangeStmt(e)
+}
+
+type ExplainKind string
+
+const (
+	ExplainKindNone          ExplainKind = "None"
+	ExplainKindAST           ExplainKind = "AST"
+	ExplainKindSyntax        ExplainKind = "SYNTAX"
+	ExplainKindQueryTree     ExplainKind = "QUERY TREE"
+	ExplainKindPlan          ExplainKind = "PLAN"
+	ExplainKindPipeline      ExplainKind = "PIPELINE"
+	ExplainKindEstimate      ExplainKind = "ESTIMATE"
+	ExplainKindTableOverride ExplainKind = "TABLE OVERRIDE"
+)
+
 type ExplainExpr struct {
-	ExplainPos Pos
-	Type       string
-	Statement  Expr
+	ExplainPos  Pos
+	Kind        ExplainKind
+	Settings    []*SettingsExpr
+	Statement   Expr
+	PartitionBy *PartitionByExpr // the override of TABLE OVERRIDE
 }
 
 func (e *ExplainExpr) Pos() Pos {
@@ -5966,24 +11088,52 @@ func (e *ExplainExpr) Pos() Pos {
 }
 
 func (e *ExplainExpr) End() Pos {
+	if e.PartitionBy != nil {
+		return e.PartitionBy.End()
+	}
 	return e.Statement.End()
 }
 
 func (e *ExplainExpr) String(level int) string {
 	var builder strings.Builder
 	builder.WriteString("EXPLAIN ")
-	builder.WriteString(e.Type)
-	builder.WriteByte(' ')
+	if e.Kind != ExplainKindNone {
+		builder.WriteString(string(e.Kind))
+		builder.WriteByte(' ')
+	}
+	for i, setting := range e.Settings {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(setting.String(level))
+	}
+	if len(e.Settings) > 0 {
+		builder.WriteByte(' ')
+	}
 	builder.WriteString(e.Statement.String(level))
+	if e.PartitionBy != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(e.PartitionBy.String(level))
+	}
 	return builder.String()
 }
 
 func (e *ExplainExpr) Accept(visitor ASTVisitor) error {
 	visitor.enter(e)
 	defer visitor.leave(e)
+	for _, setting := range e.Settings {
+		if err := setting.Accept(visitor); err != nil {
+			return err
+		}
+	}
 	if err := e.Statement.Accept(visitor); err != nil {
 		return err
 	}
+	if e.PartitionBy != nil {
+		if err := e.PartitionBy.Accept(visitor); err != nil {
+			return err
+		}
+	}
 	return visitor.VisitExplainExpr(e)
 }
 
@@ -5991,7 +11141,7 @@ type PrivilegeExpr struct {
 	PrivilegePos Pos
 	PrivilegeEnd Pos
 	Keywords     []string
-	Params       *ParamExprList
+	Columns      []*Ident
 }
 
 func (p *PrivilegeExpr) Pos() Pos {
@@ -6010,8 +11160,15 @@ func (p *PrivilegeExpr) String(level int) string {
 		}
 		builder.WriteString(keyword)
 	}
-	if p.Params != nil {
-		builder.WriteString(p.Params.String(level))
+	if len(p.Columns) > 0 {
+		builder.WriteByte('(')
+		for i, column := range p.Columns {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(column.String(level))
+		}
+		builder.WriteByte(')')
 	}
 	return builder.String()
 }
@@ -6019,8 +11176,8 @@ func (p *PrivilegeExpr) String(level int) string {
 func (p *PrivilegeExpr) Accept(visitor ASTVisitor) error {
 	visitor.enter(p)
 	defer visitor.leave(p)
-	if p.Params != nil {
-		if err := p.Params.Accept(visitor); err != nil {
+	for _, column := range p.Columns {
+		if err := column.Accept(visitor); err != nil {
 			return err
 		}
 	}
@@ -6033,6 +11190,7 @@ type GrantPrivilegeExpr struct {
 	OnCluster    *OnClusterExpr
 	Privileges   []*PrivilegeExpr
 	On           *TableIdentifier
+	Roles        []*Ident // GRANT role [,...] TO ..., mutually exclusive with Privileges
 	To           []*Ident
 	WithOptions  []string
 }
@@ -6053,17 +11211,10 @@ func (g *GrantPrivilegeExpr) String(level int) string {
 	var builder strings.Builder
 	builder.WriteString("GRANT ")
 	if g.OnCluster != nil {
-		builder.WriteString(NewLine(level))
 		builder.WriteString(g.OnCluster.String(level))
+		builder.WriteByte(' ')
 	}
-	for i, privilege := range g.Privileges {
-		if i > 0 {
-			builder.WriteString(", ")
-		}
-		builder.WriteString(privilege.String(level))
-	}
-	builder.WriteString(" ON ")
-	builder.WriteString(g.On.String(level))
+	writePrivilegesOrRoles(&builder, g.Privileges, g.On, g.Roles, level)
 	builder.WriteString(" TO ")
 	for i, role := range g.To {
 		if i > 0 {
@@ -6091,8 +11242,15 @@ func (g *GrantPrivilegeExpr) Accept(visitor ASTVisitor) error {
 			return err
 		}
 	}
-	if err := g.On.Accept(visitor); err != nil {
-		return err
+	if g.On != nil {
+		if err := g.On.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, role := range g.Roles {
+		if err := role.Accept(visitor); err != nil {
+			return err
+		}
 	}
 	for _, role := range g.To {
 		if err := role.Accept(visitor); err != nil {
@@ -6101,3 +11259,364 @@ func (g *GrantPrivilegeExpr) Accept(visitor ASTVisitor) error {
 	}
 	return visitor.VisitGrantPrivilegeExpr(g)
 }
+
+func writePrivilegesOrRoles(builder *strings.Builder, privileges []*PrivilegeExpr, on *TableIdentifier, roles []*Ident, level int) {
+	if len(roles) > 0 {
+		for i, role := range roles {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(role.String(level))
+		}
+		return
+	}
+	for i, privilege := range privileges {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(privilege.String(level))
+	}
+	builder.WriteString(" ON ")
+	builder.WriteString(on.String(level))
+}
+
+type RevokeExpr struct {
+	RevokePos    Pos
+	StatementEnd Pos
+	OnCluster    *OnClusterExpr
+	OptionFor    string // GRANT or ADMIN for REVOKE GRANT OPTION FOR / ADMIN OPTION FOR
+	Privileges   []*PrivilegeExpr
+	On           *TableIdentifier
+	Roles        []*Ident // REVOKE role [,...] FROM ..., mutually exclusive with Privileges
+	From         *RoleListExpr
+}
+
+func (r *RevokeExpr) Pos() Pos {
+	return r.RevokePos
+}
+
+func (r *RevokeExpr) End() Pos {
+	return r.StatementEnd
+}
+
+func (r *RevokeExpr) Type() string {
+	return "REVOKE"
+}
+
+func (r *RevokeExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("REVOKE ")
+	if r.OnCluster != nil {
+		builder.WriteString(r.OnCluster.String(level))
+		builder.WriteByte(' ')
+	}
+	if r.OptionFor != "" {
+		builder.WriteString(r.OptionFor + " OPTION FOR ")
+	}
+	writePrivilegesOrRoles(&builder, r.Privileges, r.On, r.Roles, level)
+	builder.WriteString(" FROM ")
+	builder.WriteString(r.From.String(level))
+	return builder.String()
+}
+
+func (r *RevokeExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(r)
+	defer visitor.leave(r)
+	if r.OnCluster != nil {
+		if err := r.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, privilege := range r.Privileges {
+		if err := privilege.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if r.On != nil {
+		if err := r.On.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, role := range r.Roles {
+		if err := role.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if err := r.From.Accept(visitor); err != nil {
+		return err
+	}
+	return visitor.VisitRevokeExpr(r)
+}
+
+type WatchExpr struct {
+	WatchPos     Pos
+	StatementEnd Pos
+	Name         *TableIdentifier
+	Events       bool
+	Limit        *NumberLiteral
+	Format       *FormatExpr
+}
+
+func (w *WatchExpr) Pos() Pos {
+	return w.WatchPos
+}
+
+func (w *WatchExpr) End() Pos {
+	if w.Format != nil {
+		return w.Format.End()
+	}
+	return w.StatementEnd
+}
+
+func (w *WatchExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("WATCH ")
+	builder.WriteString(w.Name.String(level))
+	if w.Events {
+		builder.WriteString(" EVENTS")
+	}
+	if w.Limit != nil {
+		builder.WriteString(" LIMIT ")
+		builder.WriteString(w.Limit.String(level))
+	}
+	if w.Format != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(w.Format.String(level))
+	}
+	return builder.String()
+}
+
+func (w *WatchExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(w)
+	defer visitor.leave(w)
+	if err := w.Name.Accept(visitor); err != nil {
+		return err
+	}
+	if w.Limit != nil {
+		if err := w.Limit.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if w.Format != nil {
+		if err := w.Format.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitWatchExpr(w)
+}
+
+type BackupElement struct {
+	ElementPos Pos
+	ElementEnd Pos
+	Kind       string // TABLE, TEMPORARY TABLE, DICTIONARY, VIEW, DATABASE or ALL
+	Table      *TableIdentifier
+	Database   *Ident
+	// Alias is the name in the backup, it's a database identifier if the kind is DATABASE.
+	Alias      *TableIdentifier
+	Partitions []Expr
+	ExceptKind string // TABLES or DATABASES
+	Except     []*TableIdentifier
+}
+
+func (b *BackupElement) Pos() Pos {
+	return b.ElementPos
+}
+
+func (b *BackupElement) End() Pos {
+	return b.ElementEnd
+}
+
+func (b *BackupElement) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString(b.Kind)
+	if b.Table != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(b.Table.String(level))
+	}
+	if b.Database != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(b.Database.String(level))
+	}
+	if b.Alias != nil {
+		builder.WriteString(" AS ")
+		builder.WriteString(b.Alias.String(level))
+	}
+	if len(b.Partitions) > 0 {
+		builder.WriteString(" PARTITIONS ")
+		for i, partition := range b.Partitions {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(partition.String(level))
+		}
+	}
+	if b.ExceptKind != "" {
+		builder.WriteString(" EXCEPT ")
+		builder.WriteString(b.ExceptKind)
+		builder.WriteByte(' ')
+		for i, except := range b.Except {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(except.String(level))
+		}
+	}
+	return builder.String()
+}
+
+func (b *BackupElement) Accept(visitor ASTVisitor) error {
+	visitor.enter(b)
+	defer visitor.leave(b)
+	if b.Table != nil {
+		if err := b.Table.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if b.Database != nil {
+		if err := b.Database.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if b.Alias != nil {
+		if err := b.Alias.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, partition := range b.Partitions {
+		if err := partition.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	for _, except := range b.Except {
+		if err := except.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitBackupElement(b)
+}
+
+// writeBackupElements writes the elements, ON CLUSTER, the destination or source, SETTINGS
+// and the mode which are shared by BACKUP and RESTORE.
+func writeBackupElements(builder *strings.Builder, elements []*BackupElement, onCluster *OnClusterExpr,
+	direction string, engine *TableFunctionExpr, settings *SettingsExprList, mode string, level int) {
+	for i, element := range elements {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(element.String(level))
+	}
+	if onCluster != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(onCluster.String(level))
+	}
+	builder.WriteByte(' ')
+	builder.WriteString(direction)
+	builder.WriteByte(' ')
+	builder.WriteString(engine.String(level))
+	if settings != nil {
+		builder.WriteByte(' ')
+		builder.WriteString(settings.String(level))
+	}
+	if mode != "" {
+		builder.WriteByte(' ')
+		builder.WriteString(mode)
+	}
+}
+
+type BackupExpr struct {
+	BackupPos    Pos
+	StatementEnd Pos
+	Elements     []*BackupElement
+	OnCluster    *OnClusterExpr
+	Destination  *TableFunctionExpr // File(...), Disk(...), S3(...) and so on
+	Settings     *SettingsExprList
+	Mode         string // SYNC or ASYNC
+}
+
+func (b *BackupExpr) Pos() Pos {
+	return b.BackupPos
+}
+
+func (b *BackupExpr) End() Pos {
+	return b.StatementEnd
+}
+
+func (b *BackupExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("BACKUP ")
+	writeBackupElements(&builder, b.Elements, b.OnCluster, "TO", b.Destination, b.Settings, b.Mode, level)
+	return builder.String()
+}
+
+func (b *BackupExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(b)
+	defer visitor.leave(b)
+	for _, element := range b.Elements {
+		if err := element.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if b.OnCluster != nil {
+		if err := b.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if err := b.Destination.Accept(visitor); err != nil {
+		return err
+	}
+	if b.Settings != nil {
+		if err := b.Settings.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitBackupExpr(b)
+}
+
+type RestoreExpr struct {
+	RestorePos   Pos
+	StatementEnd Pos
+	Elements     []*BackupElement
+	OnCluster    *OnClusterExpr
+	Source       *TableFunctionExpr // File(...), Disk(...), S3(...) and so on
+	Settings     *SettingsExprList
+	Mode         string // SYNC or ASYNC
+}
+
+func (r *RestoreExpr) Pos() Pos {
+	return r.RestorePos
+}
+
+func (r *RestoreExpr) End() Pos {
+	return r.StatementEnd
+}
+
+func (r *RestoreExpr) String(level int) string {
+	var builder strings.Builder
+	builder.WriteString("RESTORE ")
+	writeBackupElements(&builder, r.Elements, r.OnCluster, "FROM", r.Source, r.Settings, r.Mode, level)
+	return builder.String()
+}
+
+func (r *RestoreExpr) Accept(visitor ASTVisitor) error {
+	visitor.enter(r)
+	defer visitor.leave(r)
+	for _, element := range r.Elements {
+		if err := element.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if r.OnCluster != nil {
+		if err := r.OnCluster.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	if err := r.Source.Accept(visitor); err != nil {
+		return err
+	}
+	if r.Settings != nil {
+		if err := r.Settings.Accept(visitor); err != nil {
+			return err
+		}
+	}
+	return visitor.VisitRestoreExpr(r)
+}
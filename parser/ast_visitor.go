@@ -4,6 +4,8 @@ type ASTVisitor interface {
 	VisitOperationExpr(expr *OperationExpr) error
 	VisitTernaryExpr(expr *TernaryExpr) error
 	VisitBinaryExpr(expr *BinaryExpr) error
+	VisitLambdaExpr(expr *LambdaExpr) error
+	VisitBetweenExpr(expr *BetweenExpr) error
 	VisitAlterTable(expr *AlterTable) error
 	VisitAlterTableAttachPartition(expr *AlterTableAttachPartition) error
 	VisitAlterTableDetachPartition(expr *AlterTableDetachPartition) error
@@ -20,21 +22,72 @@ type ASTVisitor interface {
 	VisitAlterTableModifyTTL(expr *AlterTableModifyTTL) error
 	VisitAlterTableModifyColumn(expr *AlterTableModifyColumn) error
 	VisitAlterTableReplacePartition(expr *AlterTableReplacePartition) error
+	VisitAlterTableUpdate(expr *AlterTableUpdate) error
+	VisitAlterTableDelete(expr *AlterTableDelete) error
+	VisitUpdateAssignment(expr *UpdateAssignment) error
+	VisitAlterTableMovePartition(expr *AlterTableMovePartition) error
+	VisitAlterTableFetchPartition(expr *AlterTableFetchPartition) error
+	VisitAlterTableMaterializeIndex(expr *AlterTableMaterializeIndex) error
+	VisitAlterTableMaterializeColumn(expr *AlterTableMaterializeColumn) error
+	VisitAlterTableMaterializeTTL(expr *AlterTableMaterializeTTL) error
+	VisitAlterTableMaterializeProjection(expr *AlterTableMaterializeProjection) error
+	VisitAlterTableAddProjection(expr *AlterTableAddProjection) error
+	VisitAlterTableDropProjection(expr *AlterTableDropProjection) error
+	VisitAlterTableClearProjection(expr *AlterTableClearProjection) error
+	VisitAlterTableAddConstraint(expr *AlterTableAddConstraint) error
+	VisitAlterTableDropConstraint(expr *AlterTableDropConstraint) error
+	VisitAlterTableModifyOrderBy(expr *AlterTableModifyOrderBy) error
+	VisitAlterTableModifySetting(expr *AlterTableModifySetting) error
+	VisitAlterTableResetSetting(expr *AlterTableResetSetting) error
+	VisitAlterTableModifyComment(expr *AlterTableModifyComment) error
+	VisitAlterTableModifyQuery(expr *AlterTableModifyQuery) error
+	VisitAlterTableCommentColumn(expr *AlterTableCommentColumn) error
+	VisitAlterTableUnfreeze(expr *AlterTableUnfreeze) error
+	VisitTableProjection(expr *TableProjection) error
+	VisitProjectionSelect(expr *ProjectionSelect) error
 	VisitRemovePropertyType(expr *RemovePropertyType) error
 	VisitTableIndex(expr *TableIndex) error
+	VisitCreateIndex(expr *CreateIndex) error
+	VisitDropIndex(expr *DropIndex) error
 	VisitIdent(expr *Ident) error
 	VisitUUID(expr *UUID) error
 	VisitCreateDatabase(expr *CreateDatabase) error
 	VisitCreateTable(expr *CreateTable) error
 	VisitCreateMaterializedView(expr *CreateMaterializedView) error
+	VisitRefreshExpr(expr *RefreshExpr) error
 	VisitCreateView(expr *CreateView) error
 	VisitCreateFunction(expr *CreateFunction) error
+	VisitCreateDictionary(expr *CreateDictionary) error
+	VisitDictionarySchemaExpr(expr *DictionarySchemaExpr) error
+	VisitDictionaryAttribute(expr *DictionaryAttribute) error
+	VisitDictionaryArgExpr(expr *DictionaryArgExpr) error
+	VisitDictionaryArgListExpr(expr *DictionaryArgListExpr) error
+	VisitDictionarySourceExpr(expr *DictionarySourceExpr) error
+	VisitDictionaryLayoutExpr(expr *DictionaryLayoutExpr) error
+	VisitDictionaryLifetimeExpr(expr *DictionaryLifetimeExpr) error
+	VisitDictionaryRangeExpr(expr *DictionaryRangeExpr) error
 	VisitRoleName(expr *RoleName) error
 	VisitSettingPair(expr *SettingPair) error
 	VisitRoleSetting(expr *RoleSetting) error
 	VisitCreateRole(expr *CreateRole) error
 	VisitAlterRole(expr *AlterRole) error
 	VisitRoleRenamePair(expr *RoleRenamePair) error
+	VisitRoleListExpr(expr *RoleListExpr) error
+	VisitAuthenticationExpr(expr *AuthenticationExpr) error
+	VisitAuthenticationMethod(expr *AuthenticationMethod) error
+	VisitHostItem(expr *HostItem) error
+	VisitHostExpr(expr *HostExpr) error
+	VisitCreateUser(expr *CreateUser) error
+	VisitAlterUser(expr *AlterUser) error
+	VisitQuotaLimitExpr(expr *QuotaLimitExpr) error
+	VisitQuotaIntervalExpr(expr *QuotaIntervalExpr) error
+	VisitCreateQuota(expr *CreateQuota) error
+	VisitAlterQuota(expr *AlterQuota) error
+	VisitRowPolicyName(expr *RowPolicyName) error
+	VisitCreateRowPolicy(expr *CreateRowPolicy) error
+	VisitAlterRowPolicy(expr *AlterRowPolicy) error
+	VisitCreateSettingsProfile(expr *CreateSettingsProfile) error
+	VisitAlterSettingsProfile(expr *AlterSettingsProfile) error
 	VisitDestinationExpr(expr *DestinationExpr) error
 	VisitConstraintExpr(expr *ConstraintExpr) error
 	VisitNullLiteral(expr *NullLiteral) error
@@ -54,13 +107,20 @@ type ASTVisitor interface {
 	VisitTTLExpr(expr *TTLExpr) error
 	VisitTTLExprList(expr *TTLExprList) error
 	VisitOrderByExpr(expr *OrderByExpr) error
+	VisitWithFillExpr(expr *WithFillExpr) error
 	VisitOrderByListExpr(expr *OrderByListExpr) error
+	VisitInterpolateExpr(expr *InterpolateExpr) error
+	VisitInterpolateItem(expr *InterpolateItem) error
 	VisitSettingsExpr(expr *SettingsExpr) error
 	VisitSettingsExprList(expr *SettingsExprList) error
 	VisitParamExprList(expr *ParamExprList) error
 	VisitArrayParamList(expr *ArrayParamList) error
-	VisitObjectParams(expr *ObjectParams) error
+	VisitMapLiteral(expr *MapLiteral) error
+	VisitIndexExpr(expr *IndexExpr) error
+	VisitTupleElementExpr(expr *TupleElementExpr) error
+	VisitSubcolumnPath(expr *SubcolumnPath) error
 	VisitFunctionExpr(expr *FunctionExpr) error
+	VisitFilterExpr(expr *FilterExpr) error
 	VisitWindowFunctionExpr(expr *WindowFunctionExpr) error
 	VisitColumn(expr *Column) error
 	VisitScalarTypeExpr(expr *ScalarTypeExpr) error
@@ -71,6 +131,7 @@ type ASTVisitor interface {
 	VisitCompressionCodec(expr *CompressionCodec) error
 	VisitNumberLiteral(expr *NumberLiteral) error
 	VisitStringLiteral(expr *StringLiteral) error
+	VisitQueryParam(expr *QueryParam) error
 	VisitRatioExpr(expr *RatioExpr) error
 	VisitEnumValueExpr(expr *EnumValueExpr) error
 	VisitEnumValueExprList(expr *EnumValueExprList) error
@@ -79,12 +140,18 @@ type ASTVisitor interface {
 	VisitColumnTypeExpr(expr *ColumnTypeExpr) error
 	VisitColumnArgList(expr *ColumnArgList) error
 	VisitColumnExprList(expr *ColumnExprList) error
+	VisitColumnsMatcher(expr *ColumnsMatcher) error
+	VisitColumnTransformerExpr(expr *ColumnTransformerExpr) error
+	VisitColumnExceptTransformer(expr *ColumnExceptTransformer) error
+	VisitColumnReplaceTransformer(expr *ColumnReplaceTransformer) error
+	VisitColumnApplyTransformer(expr *ColumnApplyTransformer) error
 	VisitWhenExpr(expr *WhenExpr) error
 	VisitCaseExpr(expr *CaseExpr) error
 	VisitCastExpr(expr *CastExpr) error
 	VisitWithExpr(expr *WithExpr) error
 	VisitTopExpr(expr *TopExpr) error
 	VisitCreateLiveView(expr *CreateLiveView) error
+	VisitCreateWindowView(expr *CreateWindowView) error
 	VisitWithTimeoutExpr(expr *WithTimeoutExpr) error
 	VisitTableExpr(expr *TableExpr) error
 	VisitOnExpr(expr *OnExpr) error
@@ -95,15 +162,18 @@ type ASTVisitor interface {
 	VisitFromExpr(expr *FromExpr) error
 	VisitIsNullExpr(expr *IsNullExpr) error
 	VisitIsNotNullExpr(expr *IsNotNullExpr) error
+	VisitIsDistinctFromExpr(expr *IsDistinctFromExpr) error
 	VisitAliasExpr(expr *AliasExpr) error
 	VisitWhereExpr(expr *WhereExpr) error
 	VisitPrewhereExpr(expr *PrewhereExpr) error
 	VisitGroupByExpr(expr *GroupByExpr) error
 	VisitHavingExpr(expr *HavingExpr) error
+	VisitQualifyExpr(expr *QualifyExpr) error
 	VisitLimitExpr(expr *LimitExpr) error
 	VisitLimitByExpr(expr *LimitByExpr) error
 	VisitWindowConditionExpr(expr *WindowConditionExpr) error
 	VisitWindowExpr(expr *WindowExpr) error
+	VisitWindowDefinition(expr *WindowDefinition) error
 	VisitWindowFrameExpr(expr *WindowFrameExpr) error
 	VisitWindowFrameExtendExpr(expr *WindowFrameExtendExpr) error
 	VisitWindowFrameRangeExpr(expr *WindowFrameRangeExpr) error
@@ -112,6 +182,8 @@ type ASTVisitor interface {
 	VisitWindowFrameNumber(expr *WindowFrameNumber) error
 	VisitArrayJoinExpr(expr *ArrayJoinExpr) error
 	VisitSelectQuery(expr *SelectQuery) error
+	VisitSetOperationExpr(expr *SetOperationExpr) error
+	VisitSelectParenExpr(expr *SelectParenExpr) error
 	VisitSubQueryExpr(expr *SubQueryExpr) error
 	VisitNotExpr(expr *NotExpr) error
 	VisitNegateExpr(expr *NegateExpr) error
@@ -119,31 +191,45 @@ type ASTVisitor interface {
 	VisitExtractExpr(expr *ExtractExpr) error
 	VisitDropDatabase(expr *DropDatabase) error
 	VisitDropStmt(expr *DropStmt) error
+	VisitUndropStmt(expr *UndropStmt) error
+	VisitNamedCollectionParam(expr *NamedCollectionParam) error
+	VisitCreateNamedCollection(expr *CreateNamedCollection) error
+	VisitAlterNamedCollection(expr *AlterNamedCollection) error
+	VisitDropNamedCollection(expr *DropNamedCollection) error
 	VisitDropUserOrRole(expr *DropUserOrRole) error
 	VisitUseExpr(expr *UseExpr) error
 	VisitCTEExpr(expr *CTEExpr) error
 	VisitSetExpr(expr *SetExpr) error
+	VisitIntoOutfileExpr(expr *IntoOutfileExpr) error
 	VisitFormatExpr(expr *FormatExpr) error
 	VisitOptimizeExpr(expr *OptimizeExpr) error
 	VisitDeduplicateExpr(expr *DeduplicateExpr) error
 	VisitSystemExpr(expr *SystemExpr) error
-	VisitSystemFlushExpr(expr *SystemFlushExpr) error
-	VisitSystemReloadExpr(expr *SystemReloadExpr) error
-	VisitSystemSyncExpr(expr *SystemSyncExpr) error
-	VisitSystemCtrlExpr(expr *SystemCtrlExpr) error
-	VisitSystemDropExpr(expr *SystemDropExpr) error
 	VisitTruncateTable(expr *TruncateTable) error
 	VisitSampleRatioExpr(expr *SampleRatioExpr) error
 	VisitDeleteFromExpr(expr *DeleteFromExpr) error
+	VisitUpdateExpr(expr *UpdateExpr) error
 	VisitColumnNamesExpr(expr *ColumnNamesExpr) error
 	VisitValuesExpr(expr *ValuesExpr) error
 	VisitInsertExpr(expr *InsertExpr) error
 	VisitCheckExpr(expr *CheckExpr) error
+	VisitShowExpr(expr *ShowExpr) error
+	VisitShowCreateExpr(expr *ShowCreateExpr) error
+	VisitShowGrantsExpr(expr *ShowGrantsExpr) error
+	VisitDescribeExpr(expr *DescribeExpr) error
+	VisitExistsExpr(expr *ExistsExpr) error
+	VisitKillExpr(expr *KillExpr) error
+	VisitWatchExpr(expr *WatchExpr) error
+	VisitBackupElement(expr *BackupElement) error
+	VisitBackupExpr(expr *BackupExpr) error
+	VisitRestoreExpr(expr *RestoreExpr) error
 	VisitUnaryExpr(expr *UnaryExpr) error
 	VisitRenameStmt(expr *RenameStmt) error
+	VisitExchangeStmt(expr *ExchangeStmt) error
 	VisitExplainExpr(expr *ExplainExpr) error
 	VisitPrivilegeExpr(expr *PrivilegeExpr) error
 	VisitGrantPrivilegeExpr(expr *GrantPrivilegeExpr) error
+	VisitRevokeExpr(expr *RevokeExpr) error
 
 	enter(expr Expr)
 	leave(expr Expr)
@@ -176,6 +262,20 @@ func (v *DefaultASTVisitor) VisitBinaryExpr(expr *BinaryExpr) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitLambdaExpr(expr *LambdaExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitBetweenExpr(expr *BetweenExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitJoinTableExpr(expr *JoinTableExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -183,217 +283,574 @@ func (v *DefaultASTVisitor) VisitJoinTableExpr(expr *JoinTableExpr) error {
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTable(expr *AlterTable) error {
+func (v *DefaultASTVisitor) VisitAlterTable(expr *AlterTable) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableAttachPartition(expr *AlterTableAttachPartition) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableDetachPartition(expr *AlterTableDetachPartition) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableDropPartition(expr *AlterTableDropPartition) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableFreezePartition(expr *AlterTableFreezePartition) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableAddColumn(expr *AlterTableAddColumn) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableAddIndex(expr *AlterTableAddIndex) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableDropColumn(expr *AlterTableDropColumn) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableDropIndex(expr *AlterTableDropIndex) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableRemoveTTL(expr *AlterTableRemoveTTL) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableClearColumn(expr *AlterTableClearColumn) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableClearIndex(expr *AlterTableClearIndex) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableRenameColumn(expr *AlterTableRenameColumn) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableModifyTTL(expr *AlterTableModifyTTL) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableModifyColumn(expr *AlterTableModifyColumn) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableReplacePartition(expr *AlterTableReplacePartition) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableUpdate(expr *AlterTableUpdate) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableDelete(expr *AlterTableDelete) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitUpdateAssignment(expr *UpdateAssignment) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableMovePartition(expr *AlterTableMovePartition) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableFetchPartition(expr *AlterTableFetchPartition) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableMaterializeIndex(expr *AlterTableMaterializeIndex) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableMaterializeColumn(expr *AlterTableMaterializeColumn) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableMaterializeTTL(expr *AlterTableMaterializeTTL) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableMaterializeProjection(expr *AlterTableMaterializeProjection) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableAddProjection(expr *AlterTableAddProjection) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableDropProjection(expr *AlterTableDropProjection) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableClearProjection(expr *AlterTableClearProjection) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableAddConstraint(expr *AlterTableAddConstraint) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableDropConstraint(expr *AlterTableDropConstraint) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableModifyOrderBy(expr *AlterTableModifyOrderBy) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableModifySetting(expr *AlterTableModifySetting) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableResetSetting(expr *AlterTableResetSetting) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableModifyComment(expr *AlterTableModifyComment) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableModifyQuery(expr *AlterTableModifyQuery) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableCommentColumn(expr *AlterTableCommentColumn) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterTableUnfreeze(expr *AlterTableUnfreeze) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitTableProjection(expr *TableProjection) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitProjectionSelect(expr *ProjectionSelect) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitRemovePropertyType(expr *RemovePropertyType) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitTableIndex(expr *TableIndex) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitCreateIndex(expr *CreateIndex) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitDropIndex(expr *DropIndex) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitIdent(expr *Ident) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitUUID(expr *UUID) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitCreateDatabase(expr *CreateDatabase) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitCreateTable(expr *CreateTable) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitCreateMaterializedView(expr *CreateMaterializedView) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitRefreshExpr(expr *RefreshExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitCreateView(expr *CreateView) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitCreateFunction(expr *CreateFunction) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableAttachPartition(expr *AlterTableAttachPartition) error {
+func (v *DefaultASTVisitor) VisitCreateDictionary(expr *CreateDictionary) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableDetachPartition(expr *AlterTableDetachPartition) error {
+func (v *DefaultASTVisitor) VisitDictionarySchemaExpr(expr *DictionarySchemaExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableDropPartition(expr *AlterTableDropPartition) error {
+func (v *DefaultASTVisitor) VisitDictionaryAttribute(expr *DictionaryAttribute) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableFreezePartition(expr *AlterTableFreezePartition) error {
+func (v *DefaultASTVisitor) VisitDictionaryArgExpr(expr *DictionaryArgExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableAddColumn(expr *AlterTableAddColumn) error {
+func (v *DefaultASTVisitor) VisitDictionaryArgListExpr(expr *DictionaryArgListExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableAddIndex(expr *AlterTableAddIndex) error {
+func (v *DefaultASTVisitor) VisitDictionarySourceExpr(expr *DictionarySourceExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableDropColumn(expr *AlterTableDropColumn) error {
+func (v *DefaultASTVisitor) VisitDictionaryLayoutExpr(expr *DictionaryLayoutExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableDropIndex(expr *AlterTableDropIndex) error {
+func (v *DefaultASTVisitor) VisitDictionaryLifetimeExpr(expr *DictionaryLifetimeExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableRemoveTTL(expr *AlterTableRemoveTTL) error {
+func (v *DefaultASTVisitor) VisitDictionaryRangeExpr(expr *DictionaryRangeExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableClearColumn(expr *AlterTableClearColumn) error {
+func (v *DefaultASTVisitor) VisitRoleName(expr *RoleName) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableClearIndex(expr *AlterTableClearIndex) error {
+func (v *DefaultASTVisitor) VisitSettingPair(expr *SettingPair) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableRenameColumn(expr *AlterTableRenameColumn) error {
+func (v *DefaultASTVisitor) VisitRoleSetting(expr *RoleSetting) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableModifyTTL(expr *AlterTableModifyTTL) error {
+func (v *DefaultASTVisitor) VisitCreateRole(expr *CreateRole) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableModifyColumn(expr *AlterTableModifyColumn) error {
+func (v *DefaultASTVisitor) VisitAlterRole(expr *AlterRole) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterTableReplacePartition(expr *AlterTableReplacePartition) error {
+func (v *DefaultASTVisitor) VisitRoleRenamePair(expr *RoleRenamePair) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitRemovePropertyType(expr *RemovePropertyType) error {
+func (v *DefaultASTVisitor) VisitRoleListExpr(expr *RoleListExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitTableIndex(expr *TableIndex) error {
+func (v *DefaultASTVisitor) VisitAuthenticationExpr(expr *AuthenticationExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitIdent(expr *Ident) error {
+func (v *DefaultASTVisitor) VisitAuthenticationMethod(expr *AuthenticationMethod) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitUUID(expr *UUID) error {
+func (v *DefaultASTVisitor) VisitHostItem(expr *HostItem) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitCreateDatabase(expr *CreateDatabase) error {
+func (v *DefaultASTVisitor) VisitHostExpr(expr *HostExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitCreateTable(expr *CreateTable) error {
+func (v *DefaultASTVisitor) VisitCreateUser(expr *CreateUser) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitCreateMaterializedView(expr *CreateMaterializedView) error {
+func (v *DefaultASTVisitor) VisitAlterUser(expr *AlterUser) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitCreateView(expr *CreateView) error {
+func (v *DefaultASTVisitor) VisitQuotaLimitExpr(expr *QuotaLimitExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitCreateFunction(expr *CreateFunction) error {
+func (v *DefaultASTVisitor) VisitQuotaIntervalExpr(expr *QuotaIntervalExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitRoleName(expr *RoleName) error {
+func (v *DefaultASTVisitor) VisitCreateQuota(expr *CreateQuota) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitSettingPair(expr *SettingPair) error {
+func (v *DefaultASTVisitor) VisitAlterQuota(expr *AlterQuota) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitRoleSetting(expr *RoleSetting) error {
+func (v *DefaultASTVisitor) VisitRowPolicyName(expr *RowPolicyName) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitCreateRole(expr *CreateRole) error {
+func (v *DefaultASTVisitor) VisitCreateRowPolicy(expr *CreateRowPolicy) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitAlterRole(expr *AlterRole) error {
+func (v *DefaultASTVisitor) VisitAlterRowPolicy(expr *AlterRowPolicy) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitRoleRenamePair(expr *RoleRenamePair) error {
+func (v *DefaultASTVisitor) VisitCreateSettingsProfile(expr *CreateSettingsProfile) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterSettingsProfile(expr *AlterSettingsProfile) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
@@ -533,6 +990,13 @@ func (v *DefaultASTVisitor) VisitOrderByExpr(expr *OrderByExpr) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitWithFillExpr(expr *WithFillExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitOrderByListExpr(expr *OrderByListExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -540,6 +1004,20 @@ func (v *DefaultASTVisitor) VisitOrderByListExpr(expr *OrderByListExpr) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitInterpolateExpr(expr *InterpolateExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitInterpolateItem(expr *InterpolateItem) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitSettingsExpr(expr *SettingsExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -568,7 +1046,28 @@ func (v *DefaultASTVisitor) VisitArrayParamList(expr *ArrayParamList) error {
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitObjectParams(expr *ObjectParams) error {
+func (v *DefaultASTVisitor) VisitMapLiteral(expr *MapLiteral) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitIndexExpr(expr *IndexExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitTupleElementExpr(expr *TupleElementExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitSubcolumnPath(expr *SubcolumnPath) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
@@ -582,6 +1081,13 @@ func (v *DefaultASTVisitor) VisitFunctionExpr(expr *FunctionExpr) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitFilterExpr(expr *FilterExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitWindowFunctionExpr(expr *WindowFunctionExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -652,6 +1158,13 @@ func (v *DefaultASTVisitor) VisitStringLiteral(expr *StringLiteral) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitQueryParam(expr *QueryParam) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitRatioExpr(expr *RatioExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -708,6 +1221,41 @@ func (v *DefaultASTVisitor) VisitColumnExprList(expr *ColumnExprList) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitColumnsMatcher(expr *ColumnsMatcher) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitColumnTransformerExpr(expr *ColumnTransformerExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitColumnExceptTransformer(expr *ColumnExceptTransformer) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitColumnReplaceTransformer(expr *ColumnReplaceTransformer) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitColumnApplyTransformer(expr *ColumnApplyTransformer) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitWhenExpr(expr *WhenExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -750,6 +1298,13 @@ func (v *DefaultASTVisitor) VisitCreateLiveView(expr *CreateLiveView) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitCreateWindowView(expr *CreateWindowView) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitWithTimeoutExpr(expr *WithTimeoutExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -813,6 +1368,13 @@ func (v *DefaultASTVisitor) VisitIsNotNullExpr(expr *IsNotNullExpr) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitIsDistinctFromExpr(expr *IsDistinctFromExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitAliasExpr(expr *AliasExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -848,6 +1410,13 @@ func (v *DefaultASTVisitor) VisitHavingExpr(expr *HavingExpr) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitQualifyExpr(expr *QualifyExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitLimitExpr(expr *LimitExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -876,6 +1445,13 @@ func (v *DefaultASTVisitor) VisitWindowExpr(expr *WindowExpr) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitWindowDefinition(expr *WindowDefinition) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitWindowFrameExpr(expr *WindowFrameExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -932,6 +1508,20 @@ func (v *DefaultASTVisitor) VisitSelectQuery(expr *SelectQuery) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitSetOperationExpr(expr *SetOperationExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitSelectParenExpr(expr *SelectParenExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitSubQueryExpr(expr *SubQueryExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -981,6 +1571,41 @@ func (v *DefaultASTVisitor) VisitDropStmt(expr *DropStmt) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitUndropStmt(expr *UndropStmt) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitNamedCollectionParam(expr *NamedCollectionParam) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitCreateNamedCollection(expr *CreateNamedCollection) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitAlterNamedCollection(expr *AlterNamedCollection) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitDropNamedCollection(expr *DropNamedCollection) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitDropUserOrRole(expr *DropUserOrRole) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -1009,6 +1634,13 @@ func (v *DefaultASTVisitor) VisitSetExpr(expr *SetExpr) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitIntoOutfileExpr(expr *IntoOutfileExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitFormatExpr(expr *FormatExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -1037,84 +1669,126 @@ func (v *DefaultASTVisitor) VisitSystemExpr(expr *SystemExpr) error {
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitSystemFlushExpr(expr *SystemFlushExpr) error {
+func (v *DefaultASTVisitor) VisitTruncateTable(expr *TruncateTable) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitSystemReloadExpr(expr *SystemReloadExpr) error {
+func (v *DefaultASTVisitor) VisitSampleRatioExpr(expr *SampleRatioExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitSystemSyncExpr(expr *SystemSyncExpr) error {
+func (v *DefaultASTVisitor) VisitDeleteFromExpr(expr *DeleteFromExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitSystemCtrlExpr(expr *SystemCtrlExpr) error {
+func (v *DefaultASTVisitor) VisitUpdateExpr(expr *UpdateExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitSystemDropExpr(expr *SystemDropExpr) error {
+func (v *DefaultASTVisitor) VisitColumnNamesExpr(expr *ColumnNamesExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitTruncateTable(expr *TruncateTable) error {
+func (v *DefaultASTVisitor) VisitValuesExpr(expr *ValuesExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitSampleRatioExpr(expr *SampleRatioExpr) error {
+func (v *DefaultASTVisitor) VisitInsertExpr(expr *InsertExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitDeleteFromExpr(expr *DeleteFromExpr) error {
+func (v *DefaultASTVisitor) VisitCheckExpr(expr *CheckExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitColumnNamesExpr(expr *ColumnNamesExpr) error {
+func (v *DefaultASTVisitor) VisitShowExpr(expr *ShowExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitValuesExpr(expr *ValuesExpr) error {
+func (v *DefaultASTVisitor) VisitShowCreateExpr(expr *ShowCreateExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitInsertExpr(expr *InsertExpr) error {
+func (v *DefaultASTVisitor) VisitShowGrantsExpr(expr *ShowGrantsExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
 	return nil
 }
 
-func (v *DefaultASTVisitor) VisitCheckExpr(expr *CheckExpr) error {
+func (v *DefaultASTVisitor) VisitDescribeExpr(expr *DescribeExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitExistsExpr(expr *ExistsExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitKillExpr(expr *KillExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitWatchExpr(expr *WatchExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitBackupElement(expr *BackupElement) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitBackupExpr(expr *BackupExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
+func (v *DefaultASTVisitor) VisitRestoreExpr(expr *RestoreExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
 	}
@@ -1135,6 +1809,13 @@ func (v *DefaultASTVisitor) VisitRenameStmt(expr *RenameStmt) error {
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitExchangeStmt(expr *ExchangeStmt) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) VisitExplainExpr(expr *ExplainExpr) error {
 	if v.Visit != nil {
 		return v.Visit(expr)
@@ -1156,6 +1837,13 @@ func (v *DefaultASTVisitor) VisitGrantPrivilegeExpr(expr *GrantPrivilegeExpr) er
 	return nil
 }
 
+func (v *DefaultASTVisitor) VisitRevokeExpr(expr *RevokeExpr) error {
+	if v.Visit != nil {
+		return v.Visit(expr)
+	}
+	return nil
+}
+
 func (v *DefaultASTVisitor) enter(expr Expr) {}
 
 func (v *DefaultASTVisitor) leave(expr Expr) {}
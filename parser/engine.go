@@ -0,0 +1,609 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EngineModel is the typed model of the table engine, it's built by EngineExpr.Model.
+type EngineModel interface {
+	EngineName() string
+}
+
+// ReplicationParams are the ZooKeeper path and the replica name of the Replicated*MergeTree engines.
+type ReplicationParams struct {
+	ZooKeeperPath string
+	ReplicaName   string
+	// PathMacros and ReplicaMacros are the macro names used in the path and the replica name,
+	// like shard and replica in '/clickhouse/tables/{shard}/t' and '{replica}'.
+	PathMacros    []string
+	ReplicaMacros []string
+}
+
+// MergeTreeEngine is the engine of the MergeTree family, like
+// ReplicatedReplacingMergeTree('/clickhouse/tables/{shard}/t', '{replica}', ver).
+type MergeTreeEngine struct {
+	Engine *EngineExpr
+	// Family is the engine name without the Replicated prefix, like ReplacingMergeTree.
+	Family string
+	// Replication is nil if the engine isn't replicated, and its fields are empty if the
+	// replicated engine uses the default path and replica name.
+	Replication *ReplicationParams
+	Version     Expr   // ver of ReplacingMergeTree and VersionedCollapsingMergeTree
+	IsDeleted   Expr   // is_deleted of ReplacingMergeTree
+	Sign        Expr   // sign of CollapsingMergeTree and VersionedCollapsingMergeTree
+	SumColumns  []Expr // columns of SummingMergeTree
+	ConfigName  string // config section of GraphiteMergeTree
+}
+
+func (m *MergeTreeEngine) EngineName() string {
+	return m.Engine.Name
+}
+
+// DistributedEngine is Distributed(cluster, database, table[, sharding_key[, policy_name]]).
+type DistributedEngine struct {
+	Engine  *EngineExpr
+	Cluster string
+	// Database is empty if the database is given by a function like currentDatabase(),
+	// DatabaseExpr is the argument as written.
+	Database     string
+	DatabaseExpr Expr
+	Table        string
+	ShardingKey  Expr
+	Policy       string
+}
+
+func (d *DistributedEngine) EngineName() string {
+	return d.Engine.Name
+}
+
+// KafkaEngine is the Kafka engine, the required parameters come from either the
+// arguments or the kafka_* settings.
+type KafkaEngine struct {
+	Engine     *EngineExpr
+	BrokerList string
+	TopicList  string
+	GroupName  string
+	Format     string
+}
+
+func (k *KafkaEngine) EngineName() string {
+	return k.Engine.Name
+}
+
+// BufferEngine is Buffer(database, table, num_layers, min_time, max_time,
+// min_rows, max_rows, min_bytes, max_bytes).
+type BufferEngine struct {
+	Engine    *EngineExpr
+	Database  string
+	Table     string
+	NumLayers int64
+	MinTime   int64
+	MaxTime   int64
+	MinRows   int64
+	MaxRows   int64
+	MinBytes  int64
+	MaxBytes  int64
+}
+
+func (b *BufferEngine) EngineName() string {
+	return b.Engine.Name
+}
+
+// JoinEngine is Join(strictness, type, key, ...).
+type JoinEngine struct {
+	Engine     *EngineExpr
+	Strictness string // ANY, ALL, SEMI or ANTI
+	Type       string // LEFT, INNER, RIGHT or FULL
+	Keys       []string
+}
+
+func (j *JoinEngine) EngineName() string {
+	return j.Engine.Name
+}
+
+// NullEngine is the Null engine which discards the written data.
+type NullEngine struct {
+	Engine *EngineExpr
+}
+
+func (n *NullEngine) EngineName() string {
+	return n.Engine.Name
+}
+
+// MemoryEngine is the Memory engine.
+type MemoryEngine struct {
+	Engine *EngineExpr
+}
+
+func (m *MemoryEngine) EngineName() string {
+	return m.Engine.Name
+}
+
+type settingType int
+
+const (
+	settingInt settingType = iota + 1
+	settingBool
+	settingString
+)
+
+// settingsSpec describes the known settings of the engine. The closed spec rejects
+// the unknown settings, otherwise only the types of the known settings are checked.
+type settingsSpec struct {
+	types  map[string]settingType
+	prefix string // all settings must have the prefix if it's not empty
+	closed bool
+}
+
+var mergeTreeSettings = settingsSpec{
+	types: map[string]settingType{
+		"index_granularity":                               settingInt,
+		"index_granularity_bytes":                         settingInt,
+		"min_index_granularity_bytes":                     settingInt,
+		"enable_mixed_granularity_parts":                  settingBool,
+		"use_minimalistic_part_header_in_zookeeper":       settingBool,
+		"min_merge_bytes_to_use_direct_io":                settingInt,
+		"merge_with_ttl_timeout":                          settingInt,
+		"merge_with_recompression_ttl_timeout":            settingInt,
+		"write_final_mark":                                settingBool,
+		"storage_policy":                                  settingString,
+		"min_bytes_for_wide_part":                         settingInt,
+		"min_rows_for_wide_part":                          settingInt,
+		"max_parts_in_total":                              settingInt,
+		"max_compress_block_size":                         settingInt,
+		"min_compress_block_size":                         settingInt,
+		"ttl_only_drop_parts":                             settingBool,
+		"allow_nullable_key":                              settingBool,
+		"allow_experimental_replacing_merge_with_cleanup": settingBool,
+		"parts_to_delay_insert":                           settingInt,
+		"parts_to_throw_insert":                           settingInt,
+		"max_suspicious_broken_parts":                     settingInt,
+		"replicated_deduplication_window":                 settingInt,
+		"non_replicated_deduplication_window":             settingInt,
+		"disk":                                            settingString,
+	},
+}
+
+var distributedSettings = settingsSpec{
+	types: map[string]settingType{
+		"fsync_after_insert":                       settingBool,
+		"fsync_directories":                        settingBool,
+		"skip_unavailable_shards":                  settingBool,
+		"bytes_to_throw_insert":                    settingInt,
+		"bytes_to_delay_insert":                    settingInt,
+		"max_delay_to_insert":                      settingInt,
+		"background_insert_batch":                  settingBool,
+		"background_insert_split_batch_on_failure": settingBool,
+		"background_insert_sleep_time_ms":          settingInt,
+		"background_insert_max_sleep_time_ms":      settingInt,
+		"flush_on_detach":                          settingBool,
+	},
+	closed: true,
+}
+
+var kafkaSettings = settingsSpec{
+	types: map[string]settingType{
+		"kafka_broker_list":          settingString,
+		"kafka_topic_list":           settingString,
+		"kafka_group_name":           settingString,
+		"kafka_format":               settingString,
+		"kafka_row_delimiter":        settingString,
+		"kafka_schema":               settingString,
+		"kafka_num_consumers":        settingInt,
+		"kafka_max_block_size":       settingInt,
+		"kafka_skip_broken_messages": settingInt,
+		"kafka_commit_every_batch":   settingBool,
+		"kafka_client_id":            settingString,
+		"kafka_poll_timeout_ms":      settingInt,
+		"kafka_poll_max_batch_size":  settingInt,
+		"kafka_flush_interval_ms":    settingInt,
+		"kafka_thread_per_consumer":  settingBool,
+		"kafka_handle_error_mode":    settingString,
+		"kafka_commit_on_select":     settingBool,
+		"kafka_max_rows_per_message": settingInt,
+	},
+	prefix: "kafka_",
+}
+
+var memorySettings = settingsSpec{
+	types: map[string]settingType{
+		"min_bytes_to_keep": settingInt,
+		"max_bytes_to_keep": settingInt,
+		"min_rows_to_keep":  settingInt,
+		"max_rows_to_keep":  settingInt,
+		"compress":          settingBool,
+	},
+	closed: true,
+}
+
+var joinSettings = settingsSpec{
+	types: map[string]settingType{
+		"join_use_nulls":         settingBool,
+		"max_rows_in_join":       settingInt,
+		"max_bytes_in_join":      settingInt,
+		"join_overflow_mode":     settingString,
+		"join_any_take_last_row": settingBool,
+		"persistent":             settingBool,
+	},
+	closed: true,
+}
+
+// noSettings is the spec of the engines which don't accept any setting.
+var noSettings = settingsSpec{closed: true}
+
+// Model returns the typed model of the common engines, and validates the arguments and
+// the settings against the engine. It returns nil without error for the other engines.
+func (e *EngineExpr) Model() (EngineModel, error) {
+	args := e.args()
+	name := e.Name
+	switch {
+	case strings.HasSuffix(name, "MergeTree"):
+		return e.mergeTreeModel(args)
+	case name == "Distributed":
+		return e.distributedModel(args)
+	case name == "Kafka":
+		return e.kafkaModel(args)
+	case name == "Buffer":
+		return e.bufferModel(args)
+	case name == "Join":
+		return e.joinModel(args)
+	case strings.EqualFold(name, KeywordNull):
+		if err := e.validate(args, 0, noSettings); err != nil {
+			return nil, err
+		}
+		return &NullEngine{Engine: e}, nil
+	case name == "Memory":
+		if err := e.validate(args, 0, memorySettings); err != nil {
+			return nil, err
+		}
+		return &MemoryEngine{Engine: e}, nil
+	}
+	return nil, nil
+}
+
+func (e *EngineExpr) args() []Expr {
+	if e.Params == nil || e.Params.Items == nil {
+		return nil
+	}
+	return e.Params.Items.Items
+}
+
+func (e *EngineExpr) mergeTreeModel(args []Expr) (*MergeTreeEngine, error) {
+	model := &MergeTreeEngine{
+		Engine: e,
+		Family: strings.TrimPrefix(e.Name, "Replicated"),
+	}
+	if model.Family != e.Name {
+		model.Replication = &ReplicationParams{}
+		// the path and the replica name can be omitted to use the server defaults
+		if len(args) >= 2 {
+			if path, ok := args[0].(*StringLiteral); ok {
+				replica, ok := args[1].(*StringLiteral)
+				if !ok {
+					return nil, fmt.Errorf("%s expects the replica name to be a string, got %s", e.Name, args[1].String(0))
+				}
+				model.Replication.ZooKeeperPath = path.Value
+				model.Replication.PathMacros = parseMacros(path.Value)
+				model.Replication.ReplicaName = replica.Value
+				model.Replication.ReplicaMacros = parseMacros(replica.Value)
+				args = args[2:]
+			}
+		}
+	}
+
+	var maxArgs, minArgs int
+	switch model.Family {
+	case "MergeTree", "AggregatingMergeTree":
+	case "ReplacingMergeTree":
+		maxArgs = 2
+		if len(args) > 0 {
+			model.Version = args[0]
+		}
+		if len(args) > 1 {
+			model.IsDeleted = args[1]
+		}
+	case "SummingMergeTree":
+		maxArgs = 1
+		if len(args) > 0 {
+			if tuple, ok := args[0].(*ParamExprList); ok && tuple.Items != nil {
+				model.SumColumns = tuple.Items.Items
+			} else {
+				model.SumColumns = []Expr{args[0]}
+			}
+		}
+	case "CollapsingMergeTree":
+		minArgs, maxArgs = 1, 1
+		if len(args) > 0 {
+			model.Sign = args[0]
+		}
+	case "VersionedCollapsingMergeTree":
+		minArgs, maxArgs = 2, 2
+		if len(args) > 1 {
+			model.Sign = args[0]
+			model.Version = args[1]
+		}
+	case "GraphiteMergeTree":
+		minArgs, maxArgs = 1, 1
+		if len(args) > 0 {
+			configName, err := engineArgString(e.Name, "config_section", args[0])
+			if err != nil {
+				return nil, err
+			}
+			model.ConfigName = configName
+		}
+	default:
+		return nil, fmt.Errorf("unknown MergeTree engine: %s", e.Name)
+	}
+	if len(args) < minArgs || len(args) > maxArgs {
+		return nil, fmt.Errorf("%s expects %s, got %d", e.Name, argsCount(minArgs, maxArgs), len(args))
+	}
+	if e.OrderByListExpr == nil && e.PrimaryKey == nil {
+		return nil, fmt.Errorf("%s requires ORDER BY or PRIMARY KEY", e.Name)
+	}
+	if err := validateEngineSettings(e.Name, e.SettingsExprList, mergeTreeSettings); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+func (e *EngineExpr) distributedModel(args []Expr) (*DistributedEngine, error) {
+	if len(args) < 3 || len(args) > 5 {
+		return nil, fmt.Errorf("%s expects %s, got %d", e.Name, argsCount(3, 5), len(args))
+	}
+	if err := e.validate(nil, 0, distributedSettings); err != nil {
+		return nil, err
+	}
+	model := &DistributedEngine{Engine: e, DatabaseExpr: args[1]}
+	var err error
+	model.Cluster, err = engineArgString(e.Name, "cluster", args[0])
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := args[1].(*FunctionExpr); !ok {
+		model.Database, err = engineArgString(e.Name, "database", args[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+	model.Table, err = engineArgString(e.Name, "table", args[2])
+	if err != nil {
+		return nil, err
+	}
+	if len(args) > 3 {
+		model.ShardingKey = args[3]
+	}
+	if len(args) > 4 {
+		model.Policy, err = engineArgString(e.Name, "policy_name", args[4])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return model, nil
+}
+
+func (e *EngineExpr) kafkaModel(args []Expr) (*KafkaEngine, error) {
+	if err := e.validate(nil, 0, kafkaSettings); err != nil {
+		return nil, err
+	}
+	model := &KafkaEngine{Engine: e}
+	fields := []*string{&model.BrokerList, &model.TopicList, &model.GroupName, &model.Format}
+	names := []string{"kafka_broker_list", "kafka_topic_list", "kafka_group_name", "kafka_format"}
+	if len(args) > len(fields) {
+		return nil, fmt.Errorf("%s expects %s, got %d", e.Name, argsCount(0, len(fields)), len(args))
+	}
+	for i, arg := range args {
+		value, err := engineArgString(e.Name, names[i], arg)
+		if err != nil {
+			return nil, err
+		}
+		*fields[i] = value
+	}
+	if e.SettingsExprList != nil {
+		for _, setting := range e.SettingsExprList.Items {
+			for i, name := range names {
+				if strings.EqualFold(setting.Name.Name, name) {
+					*fields[i] = setting.Expr.(*StringLiteral).Value
+				}
+			}
+		}
+	}
+	for i, field := range fields {
+		if *field == "" {
+			return nil, fmt.Errorf("%s requires %s", e.Name, names[i])
+		}
+	}
+	return model, nil
+}
+
+func (e *EngineExpr) bufferModel(args []Expr) (*BufferEngine, error) {
+	if err := e.validate(args, 9, noSettings); err != nil {
+		return nil, err
+	}
+	model := &BufferEngine{Engine: e}
+	var err error
+	model.Database, err = engineArgString(e.Name, "database", args[0])
+	if err != nil {
+		return nil, err
+	}
+	model.Table, err = engineArgString(e.Name, "table", args[1])
+	if err != nil {
+		return nil, err
+	}
+	names := []string{"num_layers", "min_time", "max_time", "min_rows", "max_rows", "min_bytes", "max_bytes"}
+	fields := []*int64{&model.NumLayers, &model.MinTime, &model.MaxTime,
+		&model.MinRows, &model.MaxRows, &model.MinBytes, &model.MaxBytes}
+	for i, field := range fields {
+		number, ok := args[i+2].(*NumberLiteral)
+		if !ok {
+			return nil, fmt.Errorf("%s expects %s to be a number, got %s", e.Name, names[i], args[i+2].String(0))
+		}
+		*field, err = strconv.ParseInt(number.Literal, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s expects %s to be an integer, got %s", e.Name, names[i], number.Literal)
+		}
+	}
+	return model, nil
+}
+
+func (e *EngineExpr) joinModel(args []Expr) (*JoinEngine, error) {
+	if len(args) < 3 {
+		return nil, fmt.Errorf("%s expects at least 3 arguments, got %d", e.Name, len(args))
+	}
+	if err := e.validate(nil, 0, joinSettings); err != nil {
+		return nil, err
+	}
+	model := &JoinEngine{Engine: e}
+	for i, arg := range args {
+		ident, ok := arg.(*Ident)
+		if !ok {
+			return nil, fmt.Errorf("%s expects the identifier, got %s", e.Name, arg.String(0))
+		}
+		switch i {
+		case 0:
+			model.Strictness = strings.ToUpper(ident.Name)
+		case 1:
+			model.Type = strings.ToUpper(ident.Name)
+		default:
+			model.Keys = append(model.Keys, ident.Name)
+		}
+	}
+	switch model.Strictness {
+	case "ANY", "ALL", "SEMI", "ANTI":
+	default:
+		return nil, fmt.Errorf("%s expects the strictness ANY, ALL, SEMI or ANTI, got %s", e.Name, model.Strictness)
+	}
+	switch model.Type {
+	case "LEFT", "INNER", "RIGHT", "FULL":
+	default:
+		return nil, fmt.Errorf("%s expects the join type LEFT, INNER, RIGHT or FULL, got %s", e.Name, model.Type)
+	}
+	return model, nil
+}
+
+// validate checks the argument count if args isn't nil, the settings and that
+// the MergeTree only clauses are absent.
+func (e *EngineExpr) validate(args []Expr, count int, spec settingsSpec) error {
+	if args != nil || count > 0 {
+		if len(args) != count {
+			return fmt.Errorf("%s expects %s, got %d", e.Name, argsCount(count, count), len(args))
+		}
+	}
+	clauses := []struct {
+		name    string
+		present bool
+	}{
+		{"ORDER BY", e.OrderByListExpr != nil},
+		{"PARTITION BY", e.PartitionBy != nil},
+		{"PRIMARY KEY", e.PrimaryKey != nil},
+		{"SAMPLE BY", e.SampleBy != nil},
+		{"TTL", e.TTLExprList != nil},
+	}
+	for _, clause := range clauses {
+		if clause.present {
+			return fmt.Errorf("%s doesn't support %s", e.Name, clause.name)
+		}
+	}
+	return validateEngineSettings(e.Name, e.SettingsExprList, spec)
+}
+
+func validateEngineSettings(engine string, settings *SettingsExprList, spec settingsSpec) error {
+	if settings == nil {
+		return nil
+	}
+	for _, setting := range settings.Items {
+		name := strings.ToLower(setting.Name.Name)
+		if spec.prefix != "" && !strings.HasPrefix(name, spec.prefix) {
+			return fmt.Errorf("%s setting %s should have the prefix %s", engine, setting.Name.Name, spec.prefix)
+		}
+		typ, ok := spec.types[name]
+		if !ok {
+			if spec.closed {
+				return fmt.Errorf("unknown %s setting: %s", engine, setting.Name.Name)
+			}
+			continue
+		}
+		if !matchSettingType(setting.Expr, typ) {
+			return fmt.Errorf("%s setting %s expects %s, got %s",
+				engine, setting.Name.Name, typ, setting.Expr.String(0))
+		}
+	}
+	return nil
+}
+
+func matchSettingType(expr Expr, typ settingType) bool {
+	switch value := expr.(type) {
+	case *NumberLiteral:
+		switch typ {
+		case settingInt:
+			_, err := strconv.ParseInt(value.Literal, 0, 64)
+			return err == nil
+		case settingBool:
+			return value.Literal == "0" || value.Literal == "1"
+		}
+	case *StringLiteral:
+		switch typ {
+		case settingString:
+			return true
+		case settingBool:
+			return strings.EqualFold(value.Value, "true") || strings.EqualFold(value.Value, "false")
+		}
+	case *Ident:
+		return typ == settingBool && (strings.EqualFold(value.Name, "true") || strings.EqualFold(value.Name, "false"))
+	}
+	return false
+}
+
+func (s settingType) String() string {
+	switch s {
+	case settingInt:
+		return "integer"
+	case settingBool:
+		return "boolean"
+	case settingString:
+		return "string"
+	}
+	return "unknown"
+}
+
+// engineArgString returns the value of the string or identifier argument.
+func engineArgString(engine, name string, arg Expr) (string, error) {
+	switch value := arg.(type) {
+	case *StringLiteral:
+		return value.Value, nil
+	case *Ident:
+		return value.Name, nil
+	}
+	return "", fmt.Errorf("%s expects %s to be a string or identifier, got %s", engine, name, arg.String(0))
+}
+
+func argsCount(minArgs, maxArgs int) string {
+	switch {
+	case minArgs == maxArgs && maxArgs == 1:
+		return "1 argument"
+	case minArgs == maxArgs:
+		return fmt.Sprintf("%d arguments", maxArgs)
+	case minArgs == 0:
+		return fmt.Sprintf("at most %d arguments", maxArgs)
+	}
+	return fmt.Sprintf("%d to %d arguments", minArgs, maxArgs)
+}
+
+// parseMacros returns the macro names in the string, like shard and replica
+// in '/clickhouse/tables/{shard}/{replica}'.
+func parseMacros(s string) []string {
+	var macros []string
+	for {
+		start := strings.IndexByte(s, '{')
+		if start < 0 {
+			return macros
+		}
+		end := strings.IndexByte(s[start:], '}')
+		if end < 0 {
+			return macros
+		}
+		macros = append(macros, s[start+1:start+end])
+		s = s[start+end+1:]
+	}
+}
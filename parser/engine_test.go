@@ -0,0 +1,156 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func parseEngineModel(t *testing.T, sql string) (EngineModel, error) {
+	t.Helper()
+	stmts, err := NewParser(sql).ParseStatements()
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+	createTable, ok := stmts[0].(*CreateTable)
+	require.True(t, ok)
+	require.NotNil(t, createTable.Engine)
+	return createTable.Engine.Model()
+}
+
+func TestEngineExpr_Model(t *testing.T) {
+	t.Run("ReplicatedMergeTree", func(t *testing.T) {
+		model, err := parseEngineModel(t, `CREATE TABLE t (id UInt64) ENGINE = ReplicatedMergeTree('/clickhouse/tables/{layer}-{shard}/test/t', '{replica}') ORDER BY id SETTINGS index_granularity = 8192`)
+		require.NoError(t, err)
+		mergeTree, ok := model.(*MergeTreeEngine)
+		require.True(t, ok)
+		require.Equal(t, "ReplicatedMergeTree", mergeTree.EngineName())
+		require.Equal(t, "MergeTree", mergeTree.Family)
+		require.Equal(t, &ReplicationParams{
+			ZooKeeperPath: "/clickhouse/tables/{layer}-{shard}/test/t",
+			ReplicaName:   "{replica}",
+			PathMacros:    []string{"layer", "shard"},
+			ReplicaMacros: []string{"replica"},
+		}, mergeTree.Replication)
+	})
+
+	t.Run("ReplicatedReplacingMergeTree", func(t *testing.T) {
+		model, err := parseEngineModel(t, `CREATE TABLE t (id UInt64, ver UInt64, deleted UInt8) ENGINE = ReplicatedReplacingMergeTree('/clickhouse/{shard}/t', '{replica}', ver, deleted) ORDER BY id`)
+		require.NoError(t, err)
+		mergeTree := model.(*MergeTreeEngine)
+		require.Equal(t, "ReplacingMergeTree", mergeTree.Family)
+		require.Equal(t, "ver", mergeTree.Version.String(0))
+		require.Equal(t, "deleted", mergeTree.IsDeleted.String(0))
+	})
+
+	t.Run("ReplicatedMergeTree with default path", func(t *testing.T) {
+		model, err := parseEngineModel(t, `CREATE TABLE t (id UInt64) ENGINE = ReplicatedAggregatingMergeTree ORDER BY id`)
+		require.NoError(t, err)
+		mergeTree := model.(*MergeTreeEngine)
+		require.Equal(t, "AggregatingMergeTree", mergeTree.Family)
+		require.Equal(t, &ReplicationParams{}, mergeTree.Replication)
+	})
+
+	t.Run("SummingMergeTree", func(t *testing.T) {
+		model, err := parseEngineModel(t, `CREATE TABLE t (id UInt64, a UInt64, b UInt64) ENGINE = SummingMergeTree((a, b)) ORDER BY id`)
+		require.NoError(t, err)
+		mergeTree := model.(*MergeTreeEngine)
+		require.Nil(t, mergeTree.Replication)
+		require.Len(t, mergeTree.SumColumns, 2)
+	})
+
+	t.Run("CollapsingMergeTree", func(t *testing.T) {
+		model, err := parseEngineModel(t, `CREATE TABLE t (id UInt64, sign Int8) ENGINE = CollapsingMergeTree(sign) ORDER BY id`)
+		require.NoError(t, err)
+		require.Equal(t, "sign", model.(*MergeTreeEngine).Sign.String(0))
+	})
+
+	t.Run("Distributed", func(t *testing.T) {
+		model, err := parseEngineModel(t, `CREATE TABLE t AS test.t_local ENGINE = Distributed(default_cluster, test, 't_local', rand(), 'hot') SETTINGS fsync_after_insert = 0`)
+		require.NoError(t, err)
+		distributed := model.(*DistributedEngine)
+		require.Equal(t, "default_cluster", distributed.Cluster)
+		require.Equal(t, "test", distributed.Database)
+		require.Equal(t, "t_local", distributed.Table)
+		require.Equal(t, "rand()", distributed.ShardingKey.String(0))
+		require.Equal(t, "hot", distributed.Policy)
+	})
+
+	t.Run("DistributedCurrentDatabase", func(t *testing.T) {
+		model, err := parseEngineModel(t, `CREATE TABLE t AS t_local ENGINE = Distributed(cluster, currentDatabase(), t_local, rand())`)
+		require.NoError(t, err)
+		distributed := model.(*DistributedEngine)
+		require.Equal(t, "cluster", distributed.Cluster)
+		require.Empty(t, distributed.Database)
+		require.Equal(t, "currentDatabase()", distributed.DatabaseExpr.String(0))
+		require.Equal(t, "t_local", distributed.Table)
+	})
+
+	t.Run("Kafka", func(t *testing.T) {
+		model, err := parseEngineModel(t, `CREATE TABLE t (id UInt64) ENGINE = Kafka SETTINGS kafka_broker_list = 'localhost:9092', kafka_topic_list = 'events', kafka_group_name = 'group', kafka_format = 'JSONEachRow', kafka_num_consumers = 2`)
+		require.NoError(t, err)
+		require.Equal(t, &KafkaEngine{
+			Engine:     model.(*KafkaEngine).Engine,
+			BrokerList: "localhost:9092",
+			TopicList:  "events",
+			GroupName:  "group",
+			Format:     "JSONEachRow",
+		}, model)
+	})
+
+	t.Run("Buffer", func(t *testing.T) {
+		model, err := parseEngineModel(t, `CREATE TABLE t AS test.t_local ENGINE = Buffer(test, t_local, 16, 10, 100, 10000, 1000000, 10000000, 100000000)`)
+		require.NoError(t, err)
+		buffer := model.(*BufferEngine)
+		require.Equal(t, "t_local", buffer.Table)
+		require.Equal(t, int64(16), buffer.NumLayers)
+		require.Equal(t, int64(100000000), buffer.MaxBytes)
+	})
+
+	t.Run("Join", func(t *testing.T) {
+		model, err := parseEngineModel(t, `CREATE TABLE t (id UInt64, name String) ENGINE = Join(ANY, LEFT, id) SETTINGS join_use_nulls = 1`)
+		require.NoError(t, err)
+		join := model.(*JoinEngine)
+		require.Equal(t, "ANY", join.Strictness)
+		require.Equal(t, "LEFT", join.Type)
+		require.Equal(t, []string{"id"}, join.Keys)
+	})
+
+	t.Run("Null and Memory", func(t *testing.T) {
+		model, err := parseEngineModel(t, `CREATE TABLE t (id UInt64) ENGINE = Null`)
+		require.NoError(t, err)
+		require.IsType(t, &NullEngine{}, model)
+		model, err = parseEngineModel(t, `CREATE TABLE t (id UInt64) ENGINE = Memory SETTINGS max_rows_to_keep = 100`)
+		require.NoError(t, err)
+		require.IsType(t, &MemoryEngine{}, model)
+	})
+
+	t.Run("unknown engine", func(t *testing.T) {
+		model, err := parseEngineModel(t, `CREATE TABLE t (id UInt64) ENGINE = Log`)
+		require.NoError(t, err)
+		require.Nil(t, model)
+	})
+}
+
+func TestEngineExpr_ModelInvalid(t *testing.T) {
+	for _, sql := range []string{
+		`CREATE TABLE t (id UInt64) ENGINE = MergeTree`,
+		`CREATE TABLE t (id UInt64) ENGINE = MergeTree ORDER BY id SETTINGS index_granularity = 'big'`,
+		`CREATE TABLE t (id UInt64) ENGINE = ReplicatedMergeTree('/clickhouse/{shard}/t', 1) ORDER BY id`,
+		`CREATE TABLE t (id UInt64) ENGINE = CollapsingMergeTree ORDER BY id`,
+		`CREATE TABLE t (id UInt64) ENGINE = ReplacingMergeTree(a, b, c) ORDER BY id`,
+		`CREATE TABLE t (id UInt64) ENGINE = Distributed(cluster, db)`,
+		`CREATE TABLE t (id UInt64) ENGINE = Distributed(cluster, db, t) SETTINGS index_granularity = 8192`,
+		`CREATE TABLE t (id UInt64) ENGINE = Distributed(cluster, db, t) ORDER BY id`,
+		`CREATE TABLE t (id UInt64) ENGINE = Kafka SETTINGS kafka_broker_list = 'localhost:9092'`,
+		`CREATE TABLE t (id UInt64) ENGINE = Kafka('localhost:9092', 'events', 'group', 'JSONEachRow') SETTINGS num_consumers = 2`,
+		`CREATE TABLE t (id UInt64) ENGINE = Buffer(test, t_local, 16)`,
+		`CREATE TABLE t (id UInt64) ENGINE = Join(ANY, CROSS, id)`,
+		`CREATE TABLE t (id UInt64) ENGINE = Null SETTINGS max_rows_to_keep = 100`,
+		`CREATE TABLE t (id UInt64) ENGINE = Memory SETTINGS compress = 2`,
+	} {
+		t.Run(sql, func(t *testing.T) {
+			_, err := parseEngineModel(t, sql)
+			require.Error(t, err)
+		})
+	}
+}
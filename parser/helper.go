@@ -1,8 +1,11 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 func TabSpaces(level int) string {
@@ -31,3 +34,126 @@ func IsIdentStart(c byte) bool {
 func IsIdentPart(c byte) bool {
 	return '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
 }
+
+// UnescapeString decodes the escape sequences of a single-quoted string literal,
+// which are the doubled quote, \b, \f, \r, \n, \t, \0, \a, \v, \xHH and \uHHHH.
+// The escaped backslash, quotes and slash are converted to the character itself,
+// and the backslash of any other escape is kept like ClickHouse does, so '100\%' and '\d+' stay as is.
+func UnescapeString(s string) (string, error) {
+	if !strings.ContainsAny(s, "\\'") {
+		return s, nil
+	}
+	var builder strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\'' && i+1 < len(s) && s[i+1] == '\'' {
+			builder.WriteByte('\'')
+			i++
+			continue
+		}
+		if c != '\\' {
+			builder.WriteByte(c)
+			continue
+		}
+		i++
+		if i >= len(s) {
+			return "", errors.New("invalid escape sequence at the end of string")
+		}
+		switch s[i] {
+		case 'b':
+			builder.WriteByte('\b')
+		case 'f':
+			builder.WriteByte('\f')
+		case 'r':
+			builder.WriteByte('\r')
+		case 'n':
+			builder.WriteByte('\n')
+		case 't':
+			builder.WriteByte('\t')
+		case '0':
+			builder.WriteByte(0)
+		case 'a':
+			builder.WriteByte('\a')
+		case 'v':
+			builder.WriteByte('\v')
+		case 'x', 'u':
+			digits := 2
+			if s[i] == 'u' {
+				digits = 4
+			}
+			if i+digits >= len(s) {
+				return "", fmt.Errorf("invalid escape sequence: \\%s", s[i:])
+			}
+			code, err := strconv.ParseUint(s[i+1:i+1+digits], 16, 32)
+			if err != nil {
+				return "", fmt.Errorf("invalid escape sequence: \\%s", s[i:i+1+digits])
+			}
+			if s[i] == 'x' {
+				builder.WriteByte(byte(code))
+			} else {
+				builder.WriteRune(rune(code))
+			}
+			i += digits
+		default:
+			if keepsEscapeBackslash(s[i]) {
+				builder.WriteByte('\\')
+			}
+			builder.WriteByte(s[i])
+		}
+	}
+	return builder.String(), nil
+}
+
+// keepsEscapeBackslash reports whether the backslash before c is kept in the unescaped string,
+// which is the case for the characters without a special meaning after a backslash.
+func keepsEscapeBackslash(c byte) bool {
+	switch c {
+	case 'b', 'f', 'r', 'n', 't', '0', 'a', 'v', 'x', 'u', '\\', '\'', '"', '`', '/':
+		return false
+	}
+	return c >= 0x20 && c != 0x7f
+}
+
+// EscapeString escapes the string to be used as the content of a single-quoted string literal.
+func EscapeString(s string) string {
+	var builder strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '\\':
+			builder.WriteString("\\\\")
+		case '\'':
+			builder.WriteString("\\'")
+		case '\b':
+			builder.WriteString("\\b")
+		case '\f':
+			builder.WriteString("\\f")
+		case '\r':
+			builder.WriteString("\\r")
+		case '\n':
+			builder.WriteString("\\n")
+		case '\t':
+			builder.WriteString("\\t")
+		case 0:
+			builder.WriteString("\\0")
+		default:
+			if c < 0x20 || c == 0x7f {
+				builder.WriteString(fmt.Sprintf("\\x%02X", c))
+				continue
+			}
+			if c < utf8.RuneSelf {
+				builder.WriteByte(c)
+				continue
+			}
+			// keep the valid UTF-8 characters and escape the invalid bytes
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if r == utf8.RuneError && size == 1 {
+				builder.WriteString(fmt.Sprintf("\\x%02X", c))
+				continue
+			}
+			builder.WriteString(s[i : i+size])
+			i += size - 1
+		}
+	}
+	return builder.String()
+}
@@ -1,226 +1,281 @@
 package parser
 
 const (
-	KeywordAdd          = "ADD"
-	KeywordAdmin        = "ADMIN"
-	KeywordAfter        = "AFTER"
-	KeywordAlias        = "ALIAS"
-	KeywordAll          = "ALL"
-	KeywordAlter        = "ALTER"
-	KeywordAnd          = "AND"
-	KeywordAnti         = "ANTI"
-	KeywordAny          = "ANY"
-	KeywordArray        = "ARRAY"
-	KeywordAs           = "AS"
-	KeywordAsc          = "ASC"
-	KeywordAscending    = "ASCENDING"
-	KeywordAsof         = "ASOF"
-	KeywordAst          = "AST"
-	KeywordAsync        = "ASYNC"
-	KeywordAttach       = "ATTACH"
-	KeywordBetween      = "BETWEEN"
-	KeywordBoth         = "BOTH"
-	KeywordBy           = "BY"
-	KeywordCache        = "CACHE"
-	KeywordCase         = "CASE"
-	KeywordCast         = "CAST"
-	KeywordCheck        = "CHECK"
-	KeywordClear        = "CLEAR"
-	KeywordCluster      = "CLUSTER"
-	KeywordCodec        = "CODEC"
-	KeywordCollate      = "COLLATE"
-	KeywordColumn       = "COLUMN"
-	KeywordColumns      = "COLUMNS"
-	KeywordComment      = "COMMENT"
-	KeywordCompiled     = "COMPILED"
-	KeywordConfig       = "CONFIG"
-	KeywordConstraint   = "CONSTRAINT"
-	KeywordCreate       = "CREATE"
-	KeywordCross        = "CROSS"
-	KeywordCube         = "CUBE"
-	KeywordCurrent      = "CURRENT"
-	KeywordDatabase     = "DATABASE"
-	KeywordDatabases    = "DATABASES"
-	KeywordDate         = "DATE"
-	KeywordDay          = "DAY"
-	KeywordDeduplicate  = "DEDUPLICATE"
-	KeywordDefault      = "DEFAULT"
-	KeywordDelay        = "DELAY"
-	KeywordDelete       = "DELETE"
-	KeywordDesc         = "DESC"
-	KeywordDescending   = "DESCENDING"
-	KeywordDescribe     = "DESCRIBE"
-	KeywordDetach       = "DETACH"
-	KeywordDetached     = "DETACHED"
-	KeywordDictionaries = "DICTIONARIES"
-	KeywordDictionary   = "DICTIONARY"
-	KeywordDisk         = "DISK"
-	KeywordDistinct     = "DISTINCT"
-	KeywordDistributed  = "DISTRIBUTED"
-	KeywordDrop         = "DROP"
-	KeywordDNS          = "DNS"
-	KeywordElse         = "ELSE"
-	KeywordEnd          = "END"
-	KeywordEngine       = "ENGINE"
-	KeywordEstimate     = "ESTIMATE"
-	KeywordEvents       = "EVENTS"
-	KeywordExcept       = "EXCEPT"
-	KeywordExists       = "EXISTS"
-	KeywordExplain      = "EXPLAIN"
-	KeywordExpression   = "EXPRESSION"
-	KeywordExtract      = "EXTRACT"
-	KeywordFalse        = "FALSE"
-	KeywordFetches      = "FETCHES"
-	KeywordFileSystem   = "FILESYSTEM"
-	KeywordFinal        = "FINAL"
-	KeywordFirst        = "FIRST"
-	KeywordFlush        = "FLUSH"
-	KeywordFollowing    = "FOLLOWING"
-	KeywordFor          = "FOR"
-	KeywordFormat       = "FORMAT"
-	KeywordFreeze       = "FREEZE"
-	KeywordFrom         = "FROM"
-	KeywordFull         = "FULL"
-	KeywordFunction     = "FUNCTION"
-	KeywordFunctions    = "FUNCTIONS"
-	KeywordGlobal       = "GLOBAL"
-	KeywordGrant        = "GRANT"
-	KeywordGranularity  = "GRANULARITY"
-	KeywordGroup        = "GROUP"
-	KeywordHaving       = "HAVING"
-	KeywordHierarchical = "HIERARCHICAL"
-	KeywordHour         = "HOUR"
-	KeywordId           = "ID"
-	KeywordIf           = "IF"
-	KeywordIlike        = "ILIKE"
-	KeywordIn           = "IN"
-	KeywordIndex        = "INDEX"
-	KeywordInf          = "INF"
-	KeywordInjective    = "INJECTIVE"
-	KeywordInner        = "INNER"
-	KeywordInsert       = "INSERT"
-	KeywordInterval     = "INTERVAL"
-	KeywordInto         = "INTO"
-	KeywordIs           = "IS"
-	KeywordIs_object_id = "IS_OBJECT_ID"
-	KeywordJoin         = "JOIN"
-	KeywordKey          = "KEY"
-	KeywordKill         = "KILL"
-	KeywordLast         = "LAST"
-	KeywordLayout       = "LAYOUT"
-	KeywordLeading      = "LEADING"
-	KeywordLeft         = "LEFT"
-	KeywordLifetime     = "LIFETIME"
-	KeywordLike         = "LIKE"
-	KeywordLimit        = "LIMIT"
-	KeywordLive         = "LIVE"
-	KeywordLocal        = "LOCAL"
-	KeywordLogs         = "LOGS"
-	KeywordMark         = "MARK"
-	KeywordMaterialize  = "MATERIALIZE"
-	KeywordMaterialized = "MATERIALIZED"
-	KeywordMax          = "MAX"
-	KeywordMerges       = "MERGES"
-	KeywordMin          = "MIN"
-	KeywordMinute       = "MINUTE"
-	KeywordModify       = "MODIFY"
-	KeywordMonth        = "MONTH"
-	KeywordMove         = "MOVE"
-	KeywordMoves        = "MOVES"
-	KeywordMutation     = "MUTATION"
-	KeywordNan_sql      = "NAN_SQL"
-	KeywordNo           = "NO"
-	KeywordNone         = "NONE"
-	KeywordNot          = "NOT"
-	KeywordNull         = "NULL"
-	KeywordNulls        = "NULLS"
-	KeywordOffset       = "OFFSET"
-	KeywordOn           = "ON"
-	KeywordOptimize     = "OPTIMIZE"
-	KeywordOption       = "OPTION"
-	KeywordOr           = "OR"
-	KeywordOrder        = "ORDER"
-	KeywordOuter        = "OUTER"
-	KeywordOutfile      = "OUTFILE"
-	KeywordOver         = "OVER"
-	KeywordPartition    = "PARTITION"
-	KeywordPipeline     = "PIPELINE"
-	KeywordPolicy       = "POLICY"
-	KeywordPopulate     = "POPULATE"
-	KeywordPreceding    = "PRECEDING"
-	KeywordPrewhere     = "PREWHERE"
-	KeywordPrimary      = "PRIMARY"
-	KeywordProjection   = "PROJECTION"
-	KeywordQuarter      = "QUARTER"
-	KeywordQuery        = "QUERY"
-	KeywordQueues       = "QUEUES"
-	KeywordQuota        = "QUOTA"
-	KeywordRange        = "RANGE"
-	KeywordRefresh      = "REFRESH"
-	KeywordReload       = "RELOAD"
-	KeywordRemove       = "REMOVE"
-	KeywordRename       = "RENAME"
-	KeywordReplace      = "REPLACE"
-	KeywordReplica      = "REPLICA"
-	KeywordReplicated   = "REPLICATED"
-	KeywordReplication  = "REPLICATION"
-	KeywordRestart      = "RESTART"
-	KeywordRight        = "RIGHT"
-	KeywordRole         = "ROLE"
-	KeywordRollup       = "ROLLUP"
-	KeywordRow          = "ROW"
-	KeywordRows         = "ROWS"
-	KeywordSample       = "SAMPLE"
-	KeywordSecond       = "SECOND"
-	KeywordSelect       = "SELECT"
-	KeywordSemi         = "SEMI"
-	KeywordSends        = "SENDS"
-	KeywordSet          = "SET"
-	KeywordSettings     = "SETTINGS"
-	KeywordShow         = "SHOW"
-	KeywordShutdown     = "SHUTDOWN"
-	KeywordSource       = "SOURCE"
-	KeywordStart        = "START"
-	KeywordStop         = "STOP"
-	KeywordSubstring    = "SUBSTRING"
-	KeywordSync         = "SYNC"
-	KeywordSyntax       = "SYNTAX"
-	KeywordSystem       = "SYSTEM"
-	KeywordTable        = "TABLE"
-	KeywordTables       = "TABLES"
-	KeywordTemporary    = "TEMPORARY"
-	KeywordTest         = "TEST"
-	KeywordThen         = "THEN"
-	KeywordTies         = "TIES"
-	KeywordTimeout      = "TIMEOUT"
-	KeywordTimestamp    = "TIMESTAMP"
-	KeywordTo           = "TO"
-	KeywordTop          = "TOP"
-	KeywordTotals       = "TOTALS"
-	KeywordTrailing     = "TRAILING"
-	KeywordTrim         = "TRIM"
-	KeywordTrue         = "TRUE"
-	KeywordTruncate     = "TRUNCATE"
-	KeywordTtl          = "TTL"
-	KeywordType         = "TYPE"
-	KeywordUnbounded    = "UNBOUNDED"
-	KeywordUncompressed = "UNCOMPRESSED"
-	KeywordUnion        = "UNION"
-	KeywordUpdate       = "UPDATE"
-	KeywordUse          = "USE"
-	KeywordUser         = "USER"
-	KeywordUsing        = "USING"
-	KeywordUuid         = "UUID"
-	KeywordValues       = "VALUES"
-	KeywordView         = "VIEW"
-	KeywordVolume       = "VOLUME"
-	KeywordWatch        = "WATCH"
-	KeywordWeek         = "WEEK"
-	KeywordWhen         = "WHEN"
-	KeywordWhere        = "WHERE"
-	KeywordWindow       = "WINDOW"
-	KeywordWith         = "WITH"
-	KeywordYear         = "YEAR"
+	KeywordAdd             = "ADD"
+	KeywordAdmin           = "ADMIN"
+	KeywordAfter           = "AFTER"
+	KeywordAlias           = "ALIAS"
+	KeywordAll             = "ALL"
+	KeywordAllowedLateness = "ALLOWED_LATENESS"
+	KeywordAlter           = "ALTER"
+	KeywordAnd             = "AND"
+	KeywordAnti            = "ANTI"
+	KeywordAny             = "ANY"
+	KeywordAppend          = "APPEND"
+	KeywordApply           = "APPLY"
+	KeywordArray           = "ARRAY"
+	KeywordAs              = "AS"
+	KeywordAsc             = "ASC"
+	KeywordAscending       = "ASCENDING"
+	KeywordAsof            = "ASOF"
+	KeywordAssume          = "ASSUME"
+	KeywordAst             = "AST"
+	KeywordAsync           = "ASYNC"
+	KeywordAttach          = "ATTACH"
+	KeywordBackup          = "BACKUP"
+	KeywordBetween         = "BETWEEN"
+	KeywordBoth            = "BOTH"
+	KeywordBy              = "BY"
+	KeywordCache           = "CACHE"
+	KeywordCase            = "CASE"
+	KeywordCast            = "CAST"
+	KeywordCheck           = "CHECK"
+	KeywordClear           = "CLEAR"
+	KeywordClone           = "CLONE"
+	KeywordCluster         = "CLUSTER"
+	KeywordCodec           = "CODEC"
+	KeywordCollate         = "COLLATE"
+	KeywordCollection      = "COLLECTION"
+	KeywordColumn          = "COLUMN"
+	KeywordColumns         = "COLUMNS"
+	KeywordComment         = "COMMENT"
+	KeywordCompiled        = "COMPILED"
+	KeywordConfig          = "CONFIG"
+	KeywordConstraint      = "CONSTRAINT"
+	KeywordCreate          = "CREATE"
+	KeywordCross           = "CROSS"
+	KeywordCube            = "CUBE"
+	KeywordCurrent         = "CURRENT"
+	KeywordDatabase        = "DATABASE"
+	KeywordDatabases       = "DATABASES"
+	KeywordDate            = "DATE"
+	KeywordDay             = "DAY"
+	KeywordDeduplicate     = "DEDUPLICATE"
+	KeywordDefault         = "DEFAULT"
+	KeywordDelay           = "DELAY"
+	KeywordDelete          = "DELETE"
+	KeywordDepends         = "DEPENDS"
+	KeywordDesc            = "DESC"
+	KeywordDescending      = "DESCENDING"
+	KeywordDescribe        = "DESCRIBE"
+	KeywordDetach          = "DETACH"
+	KeywordDetached        = "DETACHED"
+	KeywordDictionaries    = "DICTIONARIES"
+	KeywordDictionary      = "DICTIONARY"
+	KeywordDisk            = "DISK"
+	KeywordDistinct        = "DISTINCT"
+	KeywordDistributed     = "DISTRIBUTED"
+	KeywordDrop            = "DROP"
+	KeywordDNS             = "DNS"
+	KeywordElse            = "ELSE"
+	KeywordEmpty           = "EMPTY"
+	KeywordEnd             = "END"
+	KeywordEngine          = "ENGINE"
+	KeywordEstimate        = "ESTIMATE"
+	KeywordEvents          = "EVENTS"
+	KeywordEvery           = "EVERY"
+	KeywordExcept          = "EXCEPT"
+	KeywordExchange        = "EXCHANGE"
+	KeywordExists          = "EXISTS"
+	KeywordExplain         = "EXPLAIN"
+	KeywordExpression      = "EXPRESSION"
+	KeywordExtract         = "EXTRACT"
+	KeywordFalse           = "FALSE"
+	KeywordFetch           = "FETCH"
+	KeywordFetches         = "FETCHES"
+	KeywordFileSystem      = "FILESYSTEM"
+	KeywordFill            = "FILL"
+	KeywordFilter          = "FILTER"
+	KeywordFinal           = "FINAL"
+	KeywordFirst           = "FIRST"
+	KeywordFlush           = "FLUSH"
+	KeywordFollowing       = "FOLLOWING"
+	KeywordFor             = "FOR"
+	KeywordFormat          = "FORMAT"
+	KeywordFreeze          = "FREEZE"
+	KeywordFrom            = "FROM"
+	KeywordFull            = "FULL"
+	KeywordFunction        = "FUNCTION"
+	KeywordFunctions       = "FUNCTIONS"
+	KeywordGlobal          = "GLOBAL"
+	KeywordGrant           = "GRANT"
+	KeywordGrantees        = "GRANTEES"
+	KeywordGrants          = "GRANTS"
+	KeywordGranularity     = "GRANULARITY"
+	KeywordGroup           = "GROUP"
+	KeywordGrouping        = "GROUPING"
+	KeywordGroups          = "GROUPS"
+	KeywordHaving          = "HAVING"
+	KeywordHierarchical    = "HIERARCHICAL"
+	KeywordHost            = "HOST"
+	KeywordHour            = "HOUR"
+	KeywordId              = "ID"
+	KeywordIdentified      = "IDENTIFIED"
+	KeywordIf              = "IF"
+	KeywordIgnore          = "IGNORE"
+	KeywordIlike           = "ILIKE"
+	KeywordImplicit        = "IMPLICIT"
+	KeywordIn              = "IN"
+	KeywordIndex           = "INDEX"
+	KeywordInf             = "INF"
+	KeywordInjective       = "INJECTIVE"
+	KeywordInner           = "INNER"
+	KeywordInsert          = "INSERT"
+	KeywordInterpolate     = "INTERPOLATE"
+	KeywordIntersect       = "INTERSECT"
+	KeywordInterval        = "INTERVAL"
+	KeywordInto            = "INTO"
+	KeywordIs              = "IS"
+	KeywordIs_object_id    = "IS_OBJECT_ID"
+	KeywordJoin            = "JOIN"
+	KeywordKey             = "KEY"
+	KeywordKeyed           = "KEYED"
+	KeywordKill            = "KILL"
+	KeywordLast            = "LAST"
+	KeywordLayout          = "LAYOUT"
+	KeywordLeading         = "LEADING"
+	KeywordLeft            = "LEFT"
+	KeywordLifetime        = "LIFETIME"
+	KeywordLike            = "LIKE"
+	KeywordLimit           = "LIMIT"
+	KeywordLimits          = "LIMITS"
+	KeywordLive            = "LIVE"
+	KeywordLocal           = "LOCAL"
+	KeywordLogs            = "LOGS"
+	KeywordMark            = "MARK"
+	KeywordMaterialize     = "MATERIALIZE"
+	KeywordMaterialized    = "MATERIALIZED"
+	KeywordMax             = "MAX"
+	KeywordMerges          = "MERGES"
+	KeywordMin             = "MIN"
+	KeywordMinute          = "MINUTE"
+	KeywordModify          = "MODIFY"
+	KeywordMonth           = "MONTH"
+	KeywordMove            = "MOVE"
+	KeywordMoves           = "MOVES"
+	KeywordMutation        = "MUTATION"
+	KeywordNamed           = "NAMED"
+	KeywordNan_sql         = "NAN_SQL"
+	KeywordNo              = "NO"
+	KeywordNone            = "NONE"
+	KeywordNot             = "NOT"
+	KeywordNull            = "NULL"
+	KeywordNulls           = "NULLS"
+	KeywordOffset          = "OFFSET"
+	KeywordOn              = "ON"
+	KeywordOnly            = "ONLY"
+	KeywordOptimize        = "OPTIMIZE"
+	KeywordOption          = "OPTION"
+	KeywordOr              = "OR"
+	KeywordOrder           = "ORDER"
+	KeywordOuter           = "OUTER"
+	KeywordOutfile         = "OUTFILE"
+	KeywordOver            = "OVER"
+	KeywordOverridable     = "OVERRIDABLE"
+	KeywordPartition       = "PARTITION"
+	KeywordPermanently     = "PERMANENTLY"
+	KeywordPermissive      = "PERMISSIVE"
+	KeywordPipeline        = "PIPELINE"
+	KeywordPolicy          = "POLICY"
+	KeywordPopulate        = "POPULATE"
+	KeywordPreceding       = "PRECEDING"
+	KeywordPrewhere        = "PREWHERE"
+	KeywordPrimary         = "PRIMARY"
+	KeywordProcesslist     = "PROCESSLIST"
+	KeywordProfile         = "PROFILE"
+	KeywordProjection      = "PROJECTION"
+	KeywordQualify         = "QUALIFY"
+	KeywordQuarter         = "QUARTER"
+	KeywordQuery           = "QUERY"
+	KeywordQueues          = "QUEUES"
+	KeywordQuota           = "QUOTA"
+	KeywordRandomize       = "RANDOMIZE"
+	KeywordRandomized      = "RANDOMIZED"
+	KeywordRange           = "RANGE"
+	KeywordRealm           = "REALM"
+	KeywordRecompress      = "RECOMPRESS"
+	KeywordRefresh         = "REFRESH"
+	KeywordRegexp          = "REGEXP"
+	KeywordReload          = "RELOAD"
+	KeywordRemove          = "REMOVE"
+	KeywordRename          = "RENAME"
+	KeywordReplace         = "REPLACE"
+	KeywordReplica         = "REPLICA"
+	KeywordReplicated      = "REPLICATED"
+	KeywordReplication     = "REPLICATION"
+	KeywordReset           = "RESET"
+	KeywordRespect         = "RESPECT"
+	KeywordRestart         = "RESTART"
+	KeywordRestore         = "RESTORE"
+	KeywordRestrictive     = "RESTRICTIVE"
+	KeywordRevoke          = "REVOKE"
+	KeywordRight           = "RIGHT"
+	KeywordRole            = "ROLE"
+	KeywordRollup          = "ROLLUP"
+	KeywordRow             = "ROW"
+	KeywordRows            = "ROWS"
+	KeywordSample          = "SAMPLE"
+	KeywordSecond          = "SECOND"
+	KeywordSelect          = "SELECT"
+	KeywordSemi            = "SEMI"
+	KeywordSends           = "SENDS"
+	KeywordServer          = "SERVER"
+	KeywordSet             = "SET"
+	KeywordSets            = "SETS"
+	KeywordSetting         = "SETTING"
+	KeywordSettings        = "SETTINGS"
+	KeywordShow            = "SHOW"
+	KeywordShutdown        = "SHUTDOWN"
+	KeywordSource          = "SOURCE"
+	KeywordStart           = "START"
+	KeywordStep            = "STEP"
+	KeywordStop            = "STOP"
+	KeywordStrict          = "STRICT"
+	KeywordSubstring       = "SUBSTRING"
+	KeywordSync            = "SYNC"
+	KeywordSyntax          = "SYNTAX"
+	KeywordSystem          = "SYSTEM"
+	KeywordTable           = "TABLE"
+	KeywordTables          = "TABLES"
+	KeywordTemporary       = "TEMPORARY"
+	KeywordTest            = "TEST"
+	KeywordThen            = "THEN"
+	KeywordTies            = "TIES"
+	KeywordTimeout         = "TIMEOUT"
+	KeywordTimestamp       = "TIMESTAMP"
+	KeywordTo              = "TO"
+	KeywordTop             = "TOP"
+	KeywordTotals          = "TOTALS"
+	KeywordTracking        = "TRACKING"
+	KeywordTrailing        = "TRAILING"
+	KeywordTrim            = "TRIM"
+	KeywordTrue            = "TRUE"
+	KeywordTruncate        = "TRUNCATE"
+	KeywordTtl             = "TTL"
+	KeywordType            = "TYPE"
+	KeywordUnbounded       = "UNBOUNDED"
+	KeywordUncompressed    = "UNCOMPRESSED"
+	KeywordUndrop          = "UNDROP"
+	KeywordUnfreeze        = "UNFREEZE"
+	KeywordUnion           = "UNION"
+	KeywordUntil           = "UNTIL"
+	KeywordUpdate          = "UPDATE"
+	KeywordUse             = "USE"
+	KeywordUser            = "USER"
+	KeywordUsing           = "USING"
+	KeywordUuid            = "UUID"
+	KeywordValid           = "VALID"
+	KeywordValues          = "VALUES"
+	KeywordView            = "VIEW"
+	KeywordVolume          = "VOLUME"
+	KeywordWatch           = "WATCH"
+	KeywordWatermark       = "WATERMARK"
+	KeywordWeek            = "WEEK"
+	KeywordWhen            = "WHEN"
+	KeywordWhere           = "WHERE"
+	KeywordWindow          = "WINDOW"
+	KeywordWith            = "WITH"
+	KeywordYear            = "YEAR"
 )
 
 var keywords = NewSet(
@@ -229,18 +284,23 @@ var keywords = NewSet(
 	KeywordAfter,
 	KeywordAlias,
 	KeywordAll,
+	KeywordAllowedLateness,
 	KeywordAlter,
 	KeywordAnd,
 	KeywordAnti,
 	KeywordAny,
+	KeywordAppend,
+	KeywordApply,
 	KeywordArray,
 	KeywordAs,
 	KeywordAsc,
 	KeywordAscending,
 	KeywordAsof,
+	KeywordAssume,
 	KeywordAst,
 	KeywordAsync,
 	KeywordAttach,
+	KeywordBackup,
 	KeywordBetween,
 	KeywordBoth,
 	KeywordBy,
@@ -249,9 +309,11 @@ var keywords = NewSet(
 	KeywordCast,
 	KeywordCheck,
 	KeywordClear,
+	KeywordClone,
 	KeywordCluster,
 	KeywordCodec,
 	KeywordCollate,
+	KeywordCollection,
 	KeywordColumn,
 	KeywordColumns,
 	KeywordComment,
@@ -270,6 +332,7 @@ var keywords = NewSet(
 	KeywordDefault,
 	KeywordDelay,
 	KeywordDelete,
+	KeywordDepends,
 	KeywordDesc,
 	KeywordDescending,
 	KeywordDescribe,
@@ -283,18 +346,24 @@ var keywords = NewSet(
 	KeywordDrop,
 	KeywordDNS,
 	KeywordElse,
+	KeywordEmpty,
 	KeywordEnd,
 	KeywordEngine,
 	KeywordEstimate,
 	KeywordEvents,
+	KeywordEvery,
 	KeywordExcept,
+	KeywordExchange,
 	KeywordExists,
 	KeywordExplain,
 	KeywordExpression,
 	KeywordExtract,
 	KeywordFalse,
+	KeywordFetch,
 	KeywordFetches,
 	KeywordFileSystem,
+	KeywordFill,
+	KeywordFilter,
 	KeywordFinal,
 	KeywordFirst,
 	KeywordFlush,
@@ -308,26 +377,37 @@ var keywords = NewSet(
 	KeywordFunctions,
 	KeywordGlobal,
 	KeywordGrant,
+	KeywordGrantees,
+	KeywordGrants,
 	KeywordGranularity,
 	KeywordGroup,
+	KeywordGrouping,
+	KeywordGroups,
 	KeywordHaving,
 	KeywordHierarchical,
+	KeywordHost,
 	KeywordHour,
 	KeywordId,
+	KeywordIdentified,
 	KeywordIf,
+	KeywordIgnore,
 	KeywordIlike,
+	KeywordImplicit,
 	KeywordIn,
 	KeywordIndex,
 	KeywordInf,
 	KeywordInjective,
 	KeywordInner,
 	KeywordInsert,
+	KeywordInterpolate,
+	KeywordIntersect,
 	KeywordInterval,
 	KeywordInto,
 	KeywordIs,
 	KeywordIs_object_id,
 	KeywordJoin,
 	KeywordKey,
+	KeywordKeyed,
 	KeywordKill,
 	KeywordLast,
 	KeywordLayout,
@@ -336,6 +416,7 @@ var keywords = NewSet(
 	KeywordLifetime,
 	KeywordLike,
 	KeywordLimit,
+	KeywordLimits,
 	KeywordLive,
 	KeywordLocal,
 	KeywordLogs,
@@ -351,6 +432,7 @@ var keywords = NewSet(
 	KeywordMove,
 	KeywordMoves,
 	KeywordMutation,
+	KeywordNamed,
 	KeywordNan_sql,
 	KeywordNo,
 	KeywordNone,
@@ -359,6 +441,7 @@ var keywords = NewSet(
 	KeywordNulls,
 	KeywordOffset,
 	KeywordOn,
+	KeywordOnly,
 	KeywordOptimize,
 	KeywordOption,
 	KeywordOr,
@@ -366,19 +449,31 @@ var keywords = NewSet(
 	KeywordOuter,
 	KeywordOutfile,
 	KeywordOver,
+	KeywordOverridable,
 	KeywordPartition,
+	KeywordPermanently,
+	KeywordPermissive,
 	KeywordPipeline,
 	KeywordPolicy,
 	KeywordPopulate,
 	KeywordPreceding,
 	KeywordPrewhere,
 	KeywordPrimary,
+	KeywordProcesslist,
+	KeywordProfile,
 	KeywordProjection,
+	KeywordQualify,
 	KeywordQuarter,
 	KeywordQuery,
 	KeywordQueues,
 	KeywordQuota,
+	KeywordRandomize,
+	KeywordRandomized,
 	KeywordRange,
+	KeywordRealm,
+	KeywordRecompress,
+	KeywordRefresh,
+	KeywordRegexp,
 	KeywordReload,
 	KeywordRemove,
 	KeywordRename,
@@ -386,7 +481,12 @@ var keywords = NewSet(
 	KeywordReplica,
 	KeywordReplicated,
 	KeywordReplication,
+	KeywordReset,
+	KeywordRespect,
 	KeywordRestart,
+	KeywordRestore,
+	KeywordRestrictive,
+	KeywordRevoke,
 	KeywordRight,
 	KeywordRole,
 	KeywordRollup,
@@ -397,13 +497,18 @@ var keywords = NewSet(
 	KeywordSelect,
 	KeywordSemi,
 	KeywordSends,
+	KeywordServer,
 	KeywordSet,
+	KeywordSets,
+	KeywordSetting,
 	KeywordSettings,
 	KeywordShow,
 	KeywordShutdown,
 	KeywordSource,
 	KeywordStart,
+	KeywordStep,
 	KeywordStop,
+	KeywordStrict,
 	KeywordSubstring,
 	KeywordSync,
 	KeywordSyntax,
@@ -419,6 +524,7 @@ var keywords = NewSet(
 	KeywordTo,
 	KeywordTop,
 	KeywordTotals,
+	KeywordTracking,
 	KeywordTrailing,
 	KeywordTrim,
 	KeywordTrue,
@@ -427,16 +533,21 @@ var keywords = NewSet(
 	KeywordType,
 	KeywordUnbounded,
 	KeywordUncompressed,
+	KeywordUndrop,
+	KeywordUnfreeze,
 	KeywordUnion,
+	KeywordUntil,
 	KeywordUpdate,
 	KeywordUse,
 	KeywordUser,
 	KeywordUsing,
 	KeywordUuid,
+	KeywordValid,
 	KeywordValues,
 	KeywordView,
 	KeywordVolume,
 	KeywordWatch,
+	KeywordWatermark,
 	KeywordWeek,
 	KeywordWhen,
 	KeywordWhere,
@@ -21,6 +21,7 @@ const (
 	Unquoted = iota + 1
 	DoubleQuote
 	BackTicks
+	Heredoc // $$...$$ or $tag$...$tag$ string literal
 )
 
 type Pos int
@@ -125,6 +126,40 @@ func (l *Lexer) consumeNumber() error {
 	return nil
 }
 
+// isElementAccess reports whether the dot right after the previous token
+// accesses the tuple element, like `t.1`, `f(x).1` or `arr[1].2`.
+func (l *Lexer) isElementAccess(prevToken *Token) bool {
+	if prevToken == nil || prevToken.End != Pos(l.current) {
+		return false
+	}
+	switch prevToken.Kind {
+	case TokenIdent, TokenKeyword, TokenInt, ")", "]":
+		return true
+	}
+	return false
+}
+
+// consumeElementIndex consumes the tuple element index after the dot,
+// which is always an integer, so `t.2.3` won't be consumed as a float.
+func (l *Lexer) consumeElementIndex() error {
+	i := 0
+	for l.peekOk(i) && IsDigit(l.peekN(i)) {
+		i++
+	}
+	if l.peekOk(i) && IsIdentPart(l.peekN(i)) {
+		return errors.New("invalid tuple element index")
+	}
+	l.lastToken = &Token{
+		Kind:   TokenInt,
+		String: l.slice(0, i),
+		Pos:    Pos(l.current),
+		End:    Pos(l.current + i),
+		Base:   10,
+	}
+	l.skipN(i)
+	return nil
+}
+
 func (l *Lexer) consumeIdent(_ Pos) error {
 	token := &Token{}
 	quoteType := Unquoted
@@ -196,10 +231,26 @@ func (l *Lexer) consumeMultiLineComment() {
 	l.skipN(i)
 }
 
+// consumeString consumes a single-quoted string literal, the token keeps the
+// source text between the quotes and the escape sequences are decoded by the parser.
 func (l *Lexer) consumeString() error {
 	i := 1
 	endChar := byte('\'')
-	for l.peekOk(i) && l.peekN(i) != endChar {
+	for l.peekOk(i) {
+		c := l.peekN(i)
+		if c == '\\' {
+			// skip the escaped character
+			i += 2
+			continue
+		}
+		if c == endChar {
+			// the doubled quote is an escaped quote
+			if l.peekOk(i+1) && l.peekN(i+1) == endChar {
+				i += 2
+				continue
+			}
+			break
+		}
 		i++
 	}
 	if !l.peekOk(i) {
@@ -215,6 +266,36 @@ func (l *Lexer) consumeString() error {
 	return nil
 }
 
+// peekHeredocTag returns the length of the `$tag$` at the current position,
+// or 0 if the input doesn't start with a heredoc tag.
+func (l *Lexer) peekHeredocTag() int {
+	i := 1
+	for l.peekOk(i) && IsIdentPart(l.peekN(i)) {
+		i++
+	}
+	if !l.peekOk(i) || l.peekN(i) != '$' {
+		return 0
+	}
+	return i + 1
+}
+
+func (l *Lexer) consumeHeredoc(tagLen int) error {
+	tag := l.slice(0, tagLen)
+	n := strings.Index(l.input[l.current+tagLen:], tag)
+	if n < 0 {
+		return fmt.Errorf("unclosed heredoc: %s", tag)
+	}
+	l.lastToken = &Token{
+		Kind:      TokenString,
+		String:    l.slice(tagLen, tagLen+n),
+		Pos:       Pos(l.current + tagLen),
+		End:       Pos(l.current + tagLen + n),
+		QuoteType: Heredoc,
+	}
+	l.skipN(tagLen + n + tagLen)
+	return nil
+}
+
 func (l *Lexer) skipComments() {
 	for !l.isEOF() {
 		switch l.peekN(0) {
@@ -252,9 +333,31 @@ func (l *Lexer) peekToken() (*Token, error) {
 	return token, nil
 }
 
+// peekTokens returns at most n tokens after the current token without consuming them.
+func (l *Lexer) peekTokens(n int) ([]*Token, error) {
+	saveToken := l.lastToken
+	saveCurrent := l.current
+	defer func() {
+		l.lastToken = saveToken
+		l.current = saveCurrent
+	}()
+	tokens := make([]*Token, 0, n)
+	for len(tokens) < n {
+		if err := l.consumeToken(); err != nil {
+			return nil, err
+		}
+		if l.lastToken == nil {
+			break
+		}
+		tokens = append(tokens, l.lastToken)
+	}
+	return tokens, nil
+}
+
 func (l *Lexer) consumeToken() error {
 	l.skipSpace()
 	// clear last token
+	prevToken := l.lastToken
 	l.lastToken = nil
 	l.skipComments()
 	l.skipSpace()
@@ -291,8 +394,16 @@ func (l *Lexer) consumeToken() error {
 			return nil
 		}
 	case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		if prevToken != nil && prevToken.Kind == "." && prevToken.End == Pos(l.current) {
+			return l.consumeElementIndex()
+		}
 		return l.consumeNumber()
 	case '`', '$', '"':
+		if l.peekN(0) == '$' {
+			if tagLen := l.peekHeredocTag(); tagLen > 0 {
+				return l.consumeHeredoc(tagLen)
+			}
+		}
 		return l.consumeIdent(Pos(l.current))
 	case '\'':
 		return l.consumeString()
@@ -308,8 +419,9 @@ func (l *Lexer) consumeToken() error {
 			return nil
 		}
 	case '.':
-		// check if the next token is a number. If so, parse it as a float number
-		if l.peekOk(1) && IsDigit(l.peekN(1)) {
+		// check if the next token is a number. If so, parse it as a float number,
+		// unless it's the tuple element access like `t.1`
+		if l.peekOk(1) && IsDigit(l.peekN(1)) && !l.isElementAccess(prevToken) {
 			return l.consumeNumber()
 		}
 		// check if the previous lastToken is an Ident. If so, it's a field name.
@@ -337,6 +449,23 @@ func (l *Lexer) consumeToken() error {
 	return nil
 }
 
+// consumeRawData returns the input after the last token as is and moves to EOF, it's
+// used by the inline data of INSERT ... FORMAT which must not be tokenized. It returns
+// the empty data and consumes the next token if nothing but the ';' follows.
+func (l *Lexer) consumeRawData() (Pos, string, error) {
+	if l.lastToken != nil {
+		l.current = int(l.lastToken.End)
+	}
+	l.skipSpace()
+	if l.isEOF() || l.peekN(0) == ';' {
+		return Pos(l.current), "", l.consumeToken()
+	}
+	dataPos := l.current
+	l.current = len(l.input)
+	l.lastToken = nil
+	return Pos(dataPos), l.input[dataPos:], nil
+}
+
 func (l *Lexer) isEOF() bool {
 	return l.current >= len(l.input)
 }
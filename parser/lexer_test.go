@@ -44,6 +44,59 @@ func TestConsumeString(t *testing.T) {
 		require.Equal(t, strings.Trim(s, "'"), lexer.lastToken.String)
 		require.True(t, lexer.isEOF())
 	}
+
+	t.Run("Escaped string", func(t *testing.T) {
+		strs := map[string]string{
+			`'it''s'`:          `it's`,
+			`'a\'b'`:           `a'b`,
+			`'C:\\path'`:       `C:\path`,
+			`'tab\tnew\nline'`: "tab\tnew\nline",
+			`'\x41\x42'`:       "AB",
+			`'\u00e9t\u00e9'`:  "été",
+			`'unknown\d'`:      `unknown\d`,
+			`'100\%'`:          `100\%`,
+			`'\/\"'`:           `/"`,
+		}
+		for s, value := range strs {
+			lexer := NewLexer(s)
+			err := lexer.consumeToken()
+			require.NoError(t, err)
+			require.Equal(t, TokenString, lexer.lastToken.Kind)
+			require.Equal(t, s[1:len(s)-1], lexer.lastToken.String)
+			require.True(t, lexer.isEOF())
+			decoded, err := UnescapeString(lexer.lastToken.String)
+			require.NoError(t, err)
+			require.Equal(t, value, decoded)
+			reEncoded, err := UnescapeString(EscapeString(decoded))
+			require.NoError(t, err)
+			require.Equal(t, value, reEncoded)
+		}
+	})
+
+	t.Run("Heredoc string", func(t *testing.T) {
+		strs := map[string]string{
+			"$$hello world$$":          "hello world",
+			"$$it's \\n$$":             "it's \\n",
+			"$tag$SELECT 'x' $$ $tag$": "SELECT 'x' $$ ",
+			"$a_1$multi\nline$a_1$":    "multi\nline",
+		}
+		for s, value := range strs {
+			lexer := NewLexer(s)
+			err := lexer.consumeToken()
+			require.NoError(t, err)
+			require.Equal(t, TokenString, lexer.lastToken.Kind)
+			require.Equal(t, Heredoc, lexer.lastToken.QuoteType)
+			require.Equal(t, value, lexer.lastToken.String)
+			require.True(t, lexer.isEOF())
+		}
+	})
+
+	t.Run("Unterminated string", func(t *testing.T) {
+		for _, s := range []string{"'abc", "'abc\\'", "$$abc", "$tag$abc$$"} {
+			lexer := NewLexer(s)
+			require.Error(t, lexer.consumeToken())
+		}
+	})
 }
 
 func TestConsumeNumber(t *testing.T) {
@@ -175,6 +228,29 @@ func TestConsumeNumber(t *testing.T) {
 		}
 	})
 
+	t.Run("Tuple element index", func(t *testing.T) {
+		inputs := map[string][]string{
+			"t.1":      {"t", ".", "1"},
+			"t.2.3":    {"t", ".", "2", ".", "3"},
+			"f(x).1":   {"f", "(", "x", ")", ".", "1"},
+			"arr[1].2": {"arr", "[", "1", "]", ".", "2"},
+			"t .5":     {"t", ".5"},
+			"1 + .5":   {"1", "+", ".5"},
+		}
+		for input, expected := range inputs {
+			lexer := NewLexer(input)
+			tokens := make([]string, 0)
+			for {
+				require.NoError(t, lexer.consumeToken())
+				if lexer.lastToken == nil {
+					break
+				}
+				tokens = append(tokens, lexer.lastToken.String)
+			}
+			require.Equal(t, expected, tokens, input)
+		}
+	})
+
 	t.Run("Keyword", func(t *testing.T) {
 		for _, k := range keywords.Members() {
 			lexer := NewLexer(k)
@@ -19,185 +19,6 @@ func (p *Parser) parseSetExpr(pos Pos) (*SetExpr, error) {
 	}, nil
 }
 
-func (p *Parser) parseSystemFlushExpr(pos Pos) (*SystemFlushExpr, error) {
-	if err := p.consumeKeyword(KeywordFlush); err != nil {
-		return nil, err
-	}
-
-	switch {
-	case p.matchKeyword(KeywordLogs):
-		lastToken := p.last()
-		_ = p.lexer.consumeToken()
-		return &SystemFlushExpr{
-			FlushPos:     pos,
-			StatementEnd: lastToken.End,
-			Logs:         true,
-		}, nil
-	case p.tryConsumeKeyword(KeywordDistributed) != nil:
-		distributed, err := p.parseTableIdentifier(p.Pos())
-		if err != nil {
-			return nil, err
-		}
-		return &SystemFlushExpr{
-			FlushPos:     pos,
-			StatementEnd: distributed.End(),
-			Distributed:  distributed,
-		}, nil
-	default:
-		return nil, fmt.Errorf("expected LOGS|DISTRIBUTED")
-	}
-}
-
-func (p *Parser) parseSystemReloadExpr(pos Pos) (*SystemReloadExpr, error) {
-	if err := p.consumeKeyword(KeywordReload); err != nil {
-		return nil, err
-	}
-
-	switch {
-	case p.matchKeyword(KeywordDictionaries):
-		lastToken := p.last()
-		_ = p.lexer.consumeToken()
-		return &SystemReloadExpr{
-			ReloadPos:    pos,
-			StatementEnd: lastToken.End,
-			Type:         KeywordDictionaries,
-		}, nil
-	case p.tryConsumeKeyword(KeywordDictionary) != nil:
-		dictionary, err := p.parseTableIdentifier(p.Pos())
-		if err != nil {
-			return nil, err
-		}
-		return &SystemReloadExpr{
-			ReloadPos:    pos,
-			StatementEnd: dictionary.End(),
-			Type:         KeywordDictionary,
-			Dictionary:   dictionary,
-		}, nil
-	case p.tryConsumeKeyword("EMBEDDED") != nil:
-		lastToken := p.last()
-		if err := p.consumeKeyword(KeywordDictionaries); err != nil {
-			return nil, err
-		}
-		return &SystemReloadExpr{
-			ReloadPos:    pos,
-			StatementEnd: lastToken.End,
-			Type:         "EMBEDDED DICTIONARIES",
-		}, nil
-	default:
-		return nil, fmt.Errorf("expected DICTIONARIES|CONFIG")
-	}
-}
-
-func (p *Parser) parseSystemSyncExpr(pos Pos) (*SystemSyncExpr, error) {
-	if err := p.consumeKeyword(KeywordSync); err != nil {
-		return nil, err
-	}
-	if err := p.consumeKeyword(KeywordReplica); err != nil {
-		return nil, err
-	}
-	cluster, err := p.parseTableIdentifier(p.Pos())
-	if err != nil {
-		return nil, err
-	}
-	return &SystemSyncExpr{
-		SyncPos: pos,
-		Cluster: cluster,
-	}, nil
-}
-
-func (p *Parser) parseSystemCtrlExpr(pos Pos) (*SystemCtrlExpr, error) {
-	if !p.matchKeyword(KeywordStart) && !p.matchKeyword(KeywordStop) {
-		return nil, fmt.Errorf("expected START|STOP")
-	}
-	command := strings.ToUpper(p.last().String)
-	_ = p.lexer.consumeToken()
-
-	var typ string
-	switch {
-	case p.tryConsumeKeyword(KeywordDistributed) != nil:
-		switch {
-		case p.matchKeyword(KeywordSends):
-			typ = "DISTRIBUTED SENDS"
-		case p.matchKeyword(KeywordFetches):
-			typ = "FETCHES"
-		case p.matchKeyword(KeywordMerges):
-			typ = "MERGES"
-		case p.matchKeyword(KeywordTtl):
-			typ = "TTL MERGES"
-			if err := p.consumeKeyword(KeywordMerges); err != nil {
-				return nil, err
-			}
-		default:
-			return nil, fmt.Errorf("expected SENDS|FETCHES|MERGES|TTL")
-		}
-		cluster, err := p.parseTableIdentifier(p.Pos())
-		if err != nil {
-			return nil, err
-		}
-		return &SystemCtrlExpr{
-			CtrlPos:      pos,
-			StatementEnd: cluster.End(),
-			Command:      command,
-			Type:         typ,
-			Cluster:      cluster,
-		}, nil
-	case p.tryConsumeKeyword(KeywordReplicated) != nil:
-		lastToken := p.last()
-		if err := p.consumeKeyword(KeywordSends); err != nil {
-			return nil, err
-		}
-		typ = "REPLICATED SENDS"
-		return &SystemCtrlExpr{
-			CtrlPos:      pos,
-			StatementEnd: lastToken.End,
-			Command:      command,
-			Type:         typ,
-		}, nil
-	default:
-		return nil, fmt.Errorf("expected DISTRIBUTED|REPLICATED")
-	}
-}
-
-func (p *Parser) parseSystemDropExpr(pos Pos) (*SystemDropExpr, error) {
-	if err := p.consumeKeyword(KeywordDrop); err != nil {
-		return nil, err
-	}
-	switch {
-	case p.matchKeyword(KeywordDNS),
-		p.matchKeyword(KeywordMark),
-		p.matchKeyword(KeywordUncompressed),
-		p.matchKeyword(KeywordFileSystem),
-		p.matchKeyword(KeywordQuery):
-		prefixToken := p.last()
-		_ = p.lexer.consumeToken()
-		lastToken := p.last()
-		if err := p.consumeKeyword(KeywordCache); err != nil {
-			return nil, err
-		}
-		return &SystemDropExpr{
-			DropPos:      pos,
-			StatementEnd: lastToken.End,
-			Type:         prefixToken.String + " CACHE",
-		}, nil
-	case p.matchKeyword(KeywordCompiled):
-		_ = p.lexer.consumeToken()
-		if err := p.consumeKeyword(KeywordExpression); err != nil {
-			return nil, err
-		}
-		lastToken := p.last()
-		if err := p.consumeKeyword(KeywordCache); err != nil {
-			return nil, err
-		}
-		return &SystemDropExpr{
-			DropPos:      pos,
-			StatementEnd: lastToken.End,
-			Type:         "COMPILED EXPRESSION CACHE",
-		}, nil
-	default:
-		return nil, fmt.Errorf("expected DNS|MARK|REPLICA|DATABASE|UNCOMPRESSION|COMPILED|QUERY")
-	}
-}
-
 func (p *Parser) tryParseDeduplicateExpr(pos Pos) (*DeduplicateExpr, error) {
 	if !p.matchKeyword(KeywordDeduplicate) {
 		return nil, nil
@@ -289,34 +110,271 @@ func (p *Parser) parseOptimizeExpr(pos Pos) (*OptimizeExpr, error) {
 	}, nil
 }
 
+type systemTarget int
+
+const (
+	systemTargetNone          systemTarget = iota
+	systemTargetTable                      // required [db.]table
+	systemTargetOptionalTable              // optional [db.]table, the command applies to all tables without it
+	systemTargetName                       // quoted name, e.g. UNFREEZE WITH NAME 'backup'
+	systemTargetReplica                    // 'replica' [FROM TABLE table | FROM DATABASE db | FROM ZKPATH 'path']
+	systemTargetTableOrVolume              // [ON VOLUME policy.volume | table]
+)
+
+// systemCommand describes the words after SYSTEM, what follows them and the trailing modifiers.
+type systemCommand struct {
+	kind      SystemCommandKind
+	target    systemTarget
+	modifiers []string
+}
+
+var systemCommands = []systemCommand{
+	{kind: SystemFlushLogs},
+	{kind: SystemFlushDistributed, target: systemTargetTable},
+	{kind: SystemReloadDictionaries},
+	{kind: SystemReloadDictionary, target: systemTargetTable},
+	{kind: SystemReloadEmbeddedDictionaries},
+	{kind: SystemReloadConfig},
+	{kind: SystemReloadUsers},
+	{kind: SystemReloadFunctions},
+	{kind: SystemReloadFunction, target: systemTargetTable},
+	{kind: SystemReloadAsynchronousMetrics},
+	{kind: SystemDropDNSCache},
+	{kind: SystemDropMarkCache},
+	{kind: SystemDropUncompressedCache},
+	{kind: SystemDropCompiledExpressionCache},
+	{kind: SystemDropQueryCache},
+	{kind: SystemDropFilesystemCache},
+	{kind: SystemDropMMapCache},
+	{kind: SystemDropSchemaCache},
+	{kind: SystemDropFormatSchemaCache},
+	{kind: SystemDropReplica, target: systemTargetReplica},
+	{kind: SystemDropDatabaseReplica, target: systemTargetReplica},
+	{kind: SystemStartMerges, target: systemTargetTableOrVolume},
+	{kind: SystemStopMerges, target: systemTargetTableOrVolume},
+	{kind: SystemStartTTLMerges, target: systemTargetOptionalTable},
+	{kind: SystemStopTTLMerges, target: systemTargetOptionalTable},
+	{kind: SystemStartMoves, target: systemTargetOptionalTable},
+	{kind: SystemStopMoves, target: systemTargetOptionalTable},
+	{kind: SystemStartFetches, target: systemTargetOptionalTable},
+	{kind: SystemStopFetches, target: systemTargetOptionalTable},
+	{kind: SystemStartReplicatedSends, target: systemTargetOptionalTable},
+	{kind: SystemStopReplicatedSends, target: systemTargetOptionalTable},
+	{kind: SystemStartDistributedSends, target: systemTargetOptionalTable},
+	{kind: SystemStopDistributedSends, target: systemTargetOptionalTable},
+	{kind: SystemStartReplicationQueues, target: systemTargetOptionalTable},
+	{kind: SystemStopReplicationQueues, target: systemTargetOptionalTable},
+	{kind: SystemStartViews},
+	{kind: SystemStopViews},
+	{kind: SystemStartView, target: systemTargetTable},
+	{kind: SystemStopView, target: systemTargetTable},
+	{kind: SystemRefreshView, target: systemTargetTable},
+	{kind: SystemCancelView, target: systemTargetTable},
+	{kind: SystemWaitView, target: systemTargetTable},
+	{kind: SystemSyncReplica, target: systemTargetTable, modifiers: []string{"STRICT", "LIGHTWEIGHT", "PULL"}},
+	{kind: SystemSyncDatabaseReplica, target: systemTargetTable},
+	{kind: SystemSyncTransactionLog},
+	{kind: SystemSyncFileCache},
+	{kind: SystemRestartReplica, target: systemTargetTable},
+	{kind: SystemRestartReplicas},
+	{kind: SystemRestoreReplica, target: systemTargetTable},
+	{kind: SystemWaitLoadingParts, target: systemTargetTable},
+	{kind: SystemUnfreeze, target: systemTargetName},
+	{kind: SystemShutdown},
+	{kind: SystemKill},
+}
+
+// matchSystemCommand consumes the longest sequence of words which is a prefix of
+// some SYSTEM commands, and returns the command matching all the consumed words
+// with the end of the last word.
+func (p *Parser) matchSystemCommand() (*systemCommand, Pos, error) {
+	candidates := make([]*systemCommand, 0, len(systemCommands))
+	for i := range systemCommands {
+		candidates = append(candidates, &systemCommands[i])
+	}
+	var words []string
+	var end Pos
+	for p.matchTokenKind(TokenIdent) {
+		word := strings.ToUpper(p.last().String)
+		next := candidates[:0:0]
+		for _, candidate := range candidates {
+			fields := strings.Fields(string(candidate.kind))
+			if len(fields) > len(words) && fields[len(words)] == word {
+				next = append(next, candidate)
+			}
+		}
+		if len(next) == 0 {
+			break
+		}
+		end = p.last().End
+		_ = p.lexer.consumeToken()
+		words = append(words, word)
+		candidates = next
+	}
+	for _, candidate := range candidates {
+		if len(strings.Fields(string(candidate.kind))) == len(words) {
+			return candidate, end, nil
+		}
+	}
+	if len(words) == 0 {
+		return nil, end, fmt.Errorf("unexpected token: %q, expected SYSTEM command", p.lastTokenKind())
+	}
+	return nil, end, fmt.Errorf("unknown SYSTEM command: %s", strings.Join(words, " "))
+}
+
 func (p *Parser) parseSystemExpr(pos Pos) (*SystemExpr, error) {
 	if err := p.consumeKeyword(KeywordSystem); err != nil {
 		return nil, err
 	}
 
-	var err error
-	var expr Expr
-	switch {
-	case p.matchKeyword(KeywordFlush):
-		expr, err = p.parseSystemFlushExpr(p.Pos())
-	case p.matchKeyword(KeywordReload):
-		expr, err = p.parseSystemReloadExpr(p.Pos())
-	case p.matchKeyword(KeywordSync):
-		expr, err = p.parseSystemSyncExpr(p.Pos())
-	case p.matchKeyword(KeywordStart), p.matchKeyword(KeywordStop):
-		expr, err = p.parseSystemCtrlExpr(p.Pos())
-	case p.matchKeyword(KeywordDrop):
-		expr, err = p.parseSystemDropExpr(p.Pos())
-	default:
-		return nil, fmt.Errorf("expected FLUSH|RELOAD|SYNC|START|STOP")
-	}
+	command, end, err := p.matchSystemCommand()
 	if err != nil {
 		return nil, err
 	}
-	return &SystemExpr{
-		SystemPos: pos,
-		Expr:      expr,
-	}, nil
+	systemExpr := &SystemExpr{
+		SystemPos:    pos,
+		StatementEnd: end,
+		Kind:         command.kind,
+	}
+
+	if !p.matchOnVolume() {
+		onCluster, err := p.tryParseOnCluster(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		if onCluster != nil {
+			systemExpr.OnCluster = onCluster
+			systemExpr.StatementEnd = onCluster.End()
+		}
+	}
+
+	switch command.target {
+	case systemTargetTable:
+		table, err := p.parseTableIdentifier(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		systemExpr.Table = table
+		systemExpr.StatementEnd = table.End()
+	case systemTargetTableOrVolume:
+		if p.matchOnVolume() {
+			_ = p.lexer.consumeToken()
+			if err := p.consumeKeyword(KeywordVolume); err != nil {
+				return nil, err
+			}
+			policy, err := p.parseIdent()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.consumeTokenKind("."); err != nil {
+				return nil, err
+			}
+			volume, err := p.parseIdent()
+			if err != nil {
+				return nil, err
+			}
+			systemExpr.Policy = policy
+			systemExpr.Volume = volume
+			systemExpr.StatementEnd = volume.End()
+			break
+		}
+		fallthrough
+	case systemTargetOptionalTable:
+		if p.matchTokenKind(TokenIdent) && !p.matchKeyword(KeywordFormat) {
+			table, err := p.parseTableIdentifier(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			systemExpr.Table = table
+			systemExpr.StatementEnd = table.End()
+		}
+	case systemTargetName:
+		name, err := p.parseString(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		systemExpr.Name = name
+		systemExpr.StatementEnd = name.End()
+	case systemTargetReplica:
+		if err := p.parseSystemDropReplica(systemExpr); err != nil {
+			return nil, err
+		}
+	}
+
+	// some commands like RESTORE REPLICA put ON CLUSTER after the table
+	if systemExpr.OnCluster == nil {
+		onCluster, err := p.tryParseOnCluster(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		if onCluster != nil {
+			systemExpr.OnCluster = onCluster
+			systemExpr.StatementEnd = onCluster.End()
+		}
+	}
+
+	for _, modifier := range command.modifiers {
+		if p.matchTokenKind(TokenIdent) && strings.EqualFold(p.last().String, modifier) {
+			systemExpr.Modifier = modifier
+			systemExpr.StatementEnd = p.last().End
+			_ = p.lexer.consumeToken()
+			break
+		}
+	}
+	return systemExpr, nil
+}
+
+// matchOnVolume reports whether the next tokens are ON VOLUME rather than ON CLUSTER.
+func (p *Parser) matchOnVolume() bool {
+	if !p.matchKeyword(KeywordOn) {
+		return false
+	}
+	nextToken, err := p.lexer.peekToken()
+	return err == nil && nextToken != nil && strings.EqualFold(nextToken.String, KeywordVolume)
+}
+
+// Syntax: 'replica' [FROM TABLE [db.]table | FROM DATABASE db | FROM ZKPATH 'path']
+func (p *Parser) parseSystemDropReplica(systemExpr *SystemExpr) error {
+	name, err := p.parseString(p.Pos())
+	if err != nil {
+		return err
+	}
+	systemExpr.Name = name
+	systemExpr.StatementEnd = name.End()
+	if p.tryConsumeKeyword(KeywordFrom) == nil {
+		return nil
+	}
+
+	switch {
+	case p.tryConsumeKeyword(KeywordTable) != nil:
+		table, err := p.parseTableIdentifier(p.Pos())
+		if err != nil {
+			return err
+		}
+		systemExpr.From = KeywordTable
+		systemExpr.Table = table
+		systemExpr.StatementEnd = table.End()
+	case p.tryConsumeKeyword(KeywordDatabase) != nil:
+		database, err := p.parseIdent()
+		if err != nil {
+			return err
+		}
+		systemExpr.From = KeywordDatabase
+		systemExpr.Database = database
+		systemExpr.StatementEnd = database.End()
+	case p.matchTokenKind(TokenIdent) && strings.EqualFold(p.last().String, "ZKPATH"):
+		_ = p.lexer.consumeToken()
+		path, err := p.parseString(p.Pos())
+		if err != nil {
+			return err
+		}
+		systemExpr.From = "ZKPATH"
+		systemExpr.ZooKeeperPath = path
+		systemExpr.StatementEnd = path.End()
+	default:
+		return fmt.Errorf("expected TABLE|DATABASE|ZKPATH, but got %q", p.lastTokenKind())
+	}
+	return nil
 }
 
 func (p *Parser) parseCheckExpr(pos Pos) (*CheckExpr, error) {
@@ -382,6 +440,37 @@ func (p *Parser) parseRoleName(_ Pos) (*RoleName, error) {
 	}
 }
 
+func (p *Parser) parseRoleNames(_ Pos) ([]*RoleName, error) {
+	roleNames := make([]*RoleName, 0)
+	for {
+		roleName, err := p.parseRoleName(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		roleNames = append(roleNames, roleName)
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	return roleNames, nil
+}
+
+// tryParseIfNotExistsOrReplace parses the optional `IF NOT EXISTS | OR REPLACE` clause
+// which follows the entity keyword of access entities, e.g. CREATE ROLE OR REPLACE r1.
+func (p *Parser) tryParseIfNotExistsOrReplace() (bool, bool, error) {
+	switch {
+	case p.matchKeyword(KeywordIf):
+		ifNotExists, err := p.tryParseIfNotExists()
+		return ifNotExists, false, err
+	case p.tryConsumeKeyword(KeywordOr) != nil:
+		if err := p.consumeKeyword(KeywordReplace); err != nil {
+			return false, false, err
+		}
+		return false, true, nil
+	}
+	return false, false, nil
+}
+
 func (p *Parser) tryParseRoleSettings(pos Pos) ([]*RoleSetting, error) {
 	if p.tryConsumeKeyword(KeywordSettings) == nil {
 		return nil, nil
@@ -391,18 +480,11 @@ func (p *Parser) tryParseRoleSettings(pos Pos) ([]*RoleSetting, error) {
 
 func (p *Parser) parseRoleSetting(_ Pos) (*RoleSetting, error) {
 	pairs := make([]*SettingPair, 0)
-	for p.matchTokenKind(TokenIdent) {
+	for p.matchTokenKind(TokenIdent) && !p.matchRoleSettingsEnd() {
 		name, err := p.parseIdent()
 		if err != nil {
 			return nil, err
 		}
-		switch name.Name {
-		case "NONE", "READABLE", "WRITABLE", "CONST", "CHANGEABLE_IN_READONLY":
-			return &RoleSetting{
-				Modifier:     name,
-				SettingPairs: pairs,
-			}, nil
-		}
 		switch {
 		case p.matchTokenKind("="),
 			p.matchTokenKind(TokenInt),
@@ -417,6 +499,12 @@ func (p *Parser) parseRoleSetting(_ Pos) (*RoleSetting, error) {
 				Name:  name,
 				Value: value,
 			})
+		case isRoleSettingModifier(name.Name):
+			// the modifier without a value, e.g. max_memory_usage = 100 READONLY
+			return &RoleSetting{
+				Modifier:     name,
+				SettingPairs: pairs,
+			}, nil
 		default:
 			pairs = append(pairs, &SettingPair{
 				Name: name,
@@ -424,11 +512,33 @@ func (p *Parser) parseRoleSetting(_ Pos) (*RoleSetting, error) {
 		}
 
 	}
+	if len(pairs) == 0 {
+		return nil, fmt.Errorf("expected setting name, but got %q", p.lastTokenKind())
+	}
 	return &RoleSetting{
 		SettingPairs: pairs,
 	}, nil
 }
 
+func isRoleSettingModifier(name string) bool {
+	switch strings.ToUpper(name) {
+	case "NONE", "READONLY", "READABLE", "WRITABLE", "CONST", "CHANGEABLE_IN_READONLY":
+		return true
+	}
+	return false
+}
+
+// matchRoleSettingsEnd reports whether the current token starts the clause
+// which may follow the SETTINGS clause of access entities.
+func (p *Parser) matchRoleSettingsEnd() bool {
+	return p.matchKeyword(KeywordTo) ||
+		p.matchKeyword(KeywordDefault) ||
+		p.matchKeyword(KeywordGrantees) ||
+		p.matchKeyword(KeywordHost) ||
+		p.matchKeyword(KeywordIdentified) ||
+		p.matchKeyword(KeywordValid)
+}
+
 func (p *Parser) parseRoleSettings(_ Pos) ([]*RoleSetting, error) {
 	settings := make([]*RoleSetting, 0)
 	for {
@@ -449,39 +559,15 @@ func (p *Parser) parseCreateRole(pos Pos) (*CreateRole, error) {
 		return nil, err
 	}
 
-	ifNotExists := false
-	orReplace := false
-	switch {
-	case p.matchKeyword(KeywordIf):
-		_ = p.lexer.consumeToken()
-		if err := p.consumeKeyword(KeywordNot); err != nil {
-			return nil, err
-		}
-		if err := p.consumeKeyword(KeywordExists); err != nil {
-			return nil, err
-		}
-		ifNotExists = true
-	case p.matchKeyword(KeywordOr):
-		_ = p.lexer.consumeToken()
-		if err := p.consumeKeyword(KeywordReplace); err != nil {
-			return nil, err
-		}
-		orReplace = true
+	ifNotExists, orReplace, err := p.tryParseIfNotExistsOrReplace()
+	if err != nil {
+		return nil, err
 	}
 
-	roleNames := make([]*RoleName, 0)
-	roleName, err := p.parseRoleName(p.Pos())
+	roleNames, err := p.parseRoleNames(p.Pos())
 	if err != nil {
 		return nil, err
 	}
-	roleNames = append(roleNames, roleName)
-	for p.tryConsumeTokenKind(",") != nil {
-		roleName, err := p.parseRoleName(p.Pos())
-		if err != nil {
-			return nil, err
-		}
-		roleNames = append(roleNames, roleName)
-	}
 	statementEnd := roleNames[len(roleNames)-1].End()
 
 	var accessStorageType *Ident
@@ -515,11 +601,23 @@ func (p *Parser) parseCreateRole(pos Pos) (*CreateRole, error) {
 func (p *Parser) parserDropUserOrRole(pos Pos) (*DropUserOrRole, error) {
 	var target string
 	switch {
-	case p.matchKeyword(KeywordUser), p.matchKeyword(KeywordRole):
+	case p.matchKeyword(KeywordUser), p.matchKeyword(KeywordRole), p.matchKeyword(KeywordQuota):
 		target = p.last().String
 		_ = p.lexer.consumeToken()
+	case p.matchKeyword(KeywordRow), p.matchKeyword(KeywordPolicy):
+		_ = p.tryConsumeKeyword(KeywordRow)
+		if err := p.consumeKeyword(KeywordPolicy); err != nil {
+			return nil, err
+		}
+		target = "ROW POLICY"
+	case p.matchKeyword(KeywordSettings), p.matchKeyword(KeywordProfile):
+		_ = p.tryConsumeKeyword(KeywordSettings)
+		if err := p.consumeKeyword(KeywordProfile); err != nil {
+			return nil, err
+		}
+		target = "SETTINGS PROFILE"
 	default:
-		return nil, fmt.Errorf("expected USER|ROLE")
+		return nil, fmt.Errorf("expected USER|ROLE|QUOTA|ROW POLICY|SETTINGS PROFILE")
 	}
 
 	ifExists, err := p.tryParseIfExists()
@@ -527,20 +625,22 @@ func (p *Parser) parserDropUserOrRole(pos Pos) (*DropUserOrRole, error) {
 		return nil, err
 	}
 
-	names := make([]*RoleName, 0)
-	name, err := p.parseRoleName(p.Pos())
-	if err != nil {
-		return nil, err
-	}
-	names = append(names, name)
-	for p.tryConsumeTokenKind(",") != nil {
-		name, err := p.parseRoleName(p.Pos())
+	var names []*RoleName
+	var policies []*RowPolicyName
+	var statementEnd Pos
+	if target == "ROW POLICY" {
+		policies, err = p.parseRowPolicyNames(p.Pos(), false)
+		if err != nil {
+			return nil, err
+		}
+		statementEnd = policies[len(policies)-1].End()
+	} else {
+		names, err = p.parseRoleNames(p.Pos())
 		if err != nil {
 			return nil, err
 		}
-		names = append(names, name)
+		statementEnd = names[len(names)-1].End()
 	}
-	statementEnd := names[len(names)-1].End()
 
 	onCluster, err := p.tryParseOnCluster(p.Pos())
 	if err != nil {
@@ -569,6 +669,8 @@ func (p *Parser) parserDropUserOrRole(pos Pos) (*DropUserOrRole, error) {
 		Target:       target,
 		IfExists:     ifExists,
 		Names:        names,
+		Policies:     policies,
+		OnCluster:    onCluster,
 		From:         from,
 		Modifier:     modifier,
 	}, nil
@@ -578,18 +680,9 @@ func (p *Parser) parsePrivilegeSelectOrInsert(pos Pos) (*PrivilegeExpr, error) {
 	keyword := p.last().String
 	_ = p.lexer.consumeToken()
 
-	var err error
-	var params *ParamExprList
-	if p.matchTokenKind("(") {
-		params, err = p.parseFunctionParams(p.Pos())
-		if err != nil {
-			return nil, err
-		}
-	}
 	return &PrivilegeExpr{
 		PrivilegePos: pos,
 		Keywords:     []string{keyword},
-		Params:       params,
 	}, nil
 }
 
@@ -819,7 +912,7 @@ func (p *Parser) parsePrivilege(pos Pos) (*PrivilegeExpr, error) {
 		return p.parsePrivilegeDrop(pos)
 	case p.tryConsumeKeyword(KeywordShow) != nil:
 		return p.parsePrivilegeShow(pos)
-	case p.matchKeyword(KeywordAll), p.matchTokenKind(KeywordNone):
+	case p.matchKeyword(KeywordAll), p.matchKeyword(KeywordNone):
 		_ = p.lexer.consumeToken()
 		return &PrivilegeExpr{
 			PrivilegePos: pos,
@@ -879,30 +972,39 @@ func (p *Parser) parsePrivilegeRoles(_ Pos) ([]*Ident, error) {
 	return roles, nil
 }
 
-func (p *Parser) parseGrantOptions(_ Pos) ([]string, error) {
+// parseGrantOptions returns the options and the end of the last OPTION keyword.
+func (p *Parser) parseGrantOptions(_ Pos) ([]string, Pos, error) {
 	options := make([]string, 0)
+	var end Pos
 	for p.matchKeyword(KeywordWith) {
-		option, err := p.parseGrantOption(p.Pos())
+		option, optionEnd, err := p.parseGrantOption(p.Pos())
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		options = append(options, option)
+		end = optionEnd
 	}
-	return options, nil
+	return options, end, nil
 }
 
-func (p *Parser) parseGrantOption(_ Pos) (string, error) {
+// WITH GRANT OPTION | WITH ADMIN OPTION | WITH REPLACE OPTION
+func (p *Parser) parseGrantOption(_ Pos) (string, Pos, error) {
 	if err := p.consumeKeyword(KeywordWith); err != nil {
-		return "", err
-	}
-	ident, err := p.parseIdent()
-	if err != nil {
-		return "", err
-	}
-	if err := p.consumeKeyword(KeywordOption); err != nil {
-		return "", err
+		return "", 0, err
 	}
-	return ident.Name, nil
+	var option string
+	switch {
+	case p.matchKeyword(KeywordGrant), p.matchKeyword(KeywordAdmin), p.matchKeyword(KeywordReplace):
+		option = strings.ToUpper(p.last().String)
+		_ = p.lexer.consumeToken()
+	default:
+		return "", 0, fmt.Errorf("expected GRANT|ADMIN|REPLACE, but got %q", p.lastTokenKind())
+	}
+	optionToken := p.tryConsumeKeyword(KeywordOption)
+	if optionToken == nil {
+		return "", 0, fmt.Errorf("expected OPTION, but got %q", p.lastTokenKind())
+	}
+	return option, optionToken.End, nil
 }
 
 func (p *Parser) parseGrantSource(_ Pos) (*TableIdentifier, error) {
@@ -926,33 +1028,96 @@ func (p *Parser) parseGrantSource(_ Pos) (*TableIdentifier, error) {
 	}, nil
 }
 
-func (p *Parser) parseGrantPrivilege(pos Pos) (*GrantPrivilegeExpr, error) {
-	if err := p.consumeKeyword(KeywordGrant); err != nil {
-		return nil, err
-	}
-	onCluster, err := p.tryParseOnCluster(p.Pos())
-	if err != nil {
-		return nil, err
-	}
-	var privileges []*PrivilegeExpr
-	privilege, err := p.parsePrivilege(p.Pos())
-	if err != nil {
-		return nil, err
-	}
-	privileges = append(privileges, privilege)
-	for p.tryConsumeTokenKind(",") != nil {
+// matchPrivilege reports whether the next token starts a privilege rather than a role name.
+func (p *Parser) matchPrivilege() bool {
+	if p.matchTokenKind(TokenIdent) && p.last().String == "dictGet" {
+		return true
+	}
+	return p.matchKeyword(KeywordSelect) || p.matchKeyword(KeywordInsert) ||
+		p.matchKeyword(KeywordAlter) || p.matchKeyword(KeywordCreate) ||
+		p.matchKeyword(KeywordDrop) || p.matchKeyword(KeywordShow) ||
+		p.matchKeyword(KeywordAll) || p.matchKeyword(KeywordNone) ||
+		p.matchKeyword(KeywordKill) || p.matchKeyword(KeywordSystem) ||
+		p.matchKeyword(KeywordAdmin) || p.matchKeyword(KeywordOptimize) ||
+		p.matchKeyword(KeywordTruncate) || p.matchKeyword(KeywordRole)
+}
+
+// privilege[(column, ...)] [, privilege[(column, ...)] ...]
+func (p *Parser) parsePrivileges(_ Pos) ([]*PrivilegeExpr, error) {
+	privileges := make([]*PrivilegeExpr, 0)
+	for {
 		privilege, err := p.parsePrivilege(p.Pos())
 		if err != nil {
 			return nil, err
 		}
+		if p.tryConsumeTokenKind("(") != nil {
+			columns, err := p.parsePrivilegeRoles(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			rightParen, err := p.consumeTokenKind(")")
+			if err != nil {
+				return nil, err
+			}
+			privilege.Columns = columns
+			privilege.PrivilegeEnd = rightParen.End
+		}
 		privileges = append(privileges, privilege)
+		if p.tryConsumeTokenKind(",") == nil {
+			return privileges, nil
+		}
+	}
+}
+
+// parsePrivilegesOrRoles parses the subject of GRANT and REVOKE statements, which is
+// either `privilege [,...] ON db.table` or `role [,...]`.
+func (p *Parser) parsePrivilegesOrRoles(pos Pos) ([]*PrivilegeExpr, *TableIdentifier, []*Ident, error) {
+	var privileges []*PrivilegeExpr
+	var err error
+	if p.matchPrivilege() {
+		privileges, err = p.parsePrivileges(pos)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	} else {
+		roles, err := p.parsePrivilegeRoles(pos)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if !p.matchKeyword(KeywordOn) {
+			return nil, nil, roles, nil
+		}
+		// the names are privileges which are not known by the parser, e.g. INTROSPECTION
+		for _, role := range roles {
+			privileges = append(privileges, &PrivilegeExpr{
+				PrivilegePos: role.NamePos,
+				PrivilegeEnd: role.NameEnd,
+				Keywords:     []string{role.Name},
+			})
+		}
 	}
-	statementEnd := privileges[len(privileges)-1].End()
 
 	if err := p.consumeKeyword(KeywordOn); err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 	on, err := p.parseGrantSource(p.Pos())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return privileges, on, nil, nil
+}
+
+// GRANT [ON CLUSTER cluster] privilege[(column, ...)] [,...] ON db.table TO user [,...] [WITH GRANT OPTION] [WITH REPLACE OPTION]
+// GRANT [ON CLUSTER cluster] role [,...] TO user [,...] [WITH ADMIN OPTION] [WITH REPLACE OPTION]
+func (p *Parser) parseGrantPrivilege(pos Pos) (*GrantPrivilegeExpr, error) {
+	if err := p.consumeKeyword(KeywordGrant); err != nil {
+		return nil, err
+	}
+	onCluster, err := p.tryParseOnCluster(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	privileges, on, roles, err := p.parsePrivilegesOrRoles(p.Pos())
 	if err != nil {
 		return nil, err
 	}
@@ -964,15 +1129,13 @@ func (p *Parser) parseGrantPrivilege(pos Pos) (*GrantPrivilegeExpr, error) {
 	if err != nil {
 		return nil, err
 	}
-	if len(toRoles) != 0 {
-		statementEnd = toRoles[len(toRoles)-1].NameEnd
-	}
-	options, err := p.parseGrantOptions(p.Pos())
+	statementEnd := toRoles[len(toRoles)-1].NameEnd
+	options, optionsEnd, err := p.parseGrantOptions(p.Pos())
 	if err != nil {
 		return nil, err
 	}
 	if len(options) != 0 {
-		statementEnd = p.last().End
+		statementEnd = optionsEnd
 	}
 
 	return &GrantPrivilegeExpr{
@@ -981,11 +1144,64 @@ func (p *Parser) parseGrantPrivilege(pos Pos) (*GrantPrivilegeExpr, error) {
 		OnCluster:    onCluster,
 		Privileges:   privileges,
 		On:           on,
+		Roles:        roles,
 		To:           toRoles,
 		WithOptions:  options,
 	}, nil
 }
 
+// REVOKE [ON CLUSTER cluster] [GRANT OPTION FOR] privilege[(column, ...)] [,...] ON db.table FROM user [,...] | ALL | ALL EXCEPT user [,...]
+// REVOKE [ON CLUSTER cluster] [ADMIN OPTION FOR] role [,...] FROM user [,...] | ALL | ALL EXCEPT user [,...]
+func (p *Parser) parseRevoke(pos Pos) (*RevokeExpr, error) {
+	if err := p.consumeKeyword(KeywordRevoke); err != nil {
+		return nil, err
+	}
+	onCluster, err := p.tryParseOnCluster(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	var optionFor string
+	switch {
+	case p.tryConsumeKeyword(KeywordGrant) != nil:
+		optionFor = KeywordGrant
+	case p.tryConsumeKeyword(KeywordAdmin) != nil:
+		optionFor = KeywordAdmin
+	}
+	if optionFor != "" {
+		if err := p.consumeKeyword(KeywordOption); err != nil {
+			return nil, err
+		}
+		if err := p.consumeKeyword(KeywordFor); err != nil {
+			return nil, err
+		}
+	}
+
+	privileges, on, roles, err := p.parsePrivilegesOrRoles(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.consumeKeyword(KeywordFrom); err != nil {
+		return nil, err
+	}
+	from, err := p.parseRoleListExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	return &RevokeExpr{
+		RevokePos:    pos,
+		StatementEnd: from.End(),
+		OnCluster:    onCluster,
+		OptionFor:    optionFor,
+		Privileges:   privileges,
+		On:           on,
+		Roles:        roles,
+		From:         from,
+	}, nil
+}
+
 func (p *Parser) parseAlterRole(pos Pos) (*AlterRole, error) {
 	if err := p.consumeKeyword(KeywordRole); err != nil {
 		return nil, err
@@ -1050,3 +1266,891 @@ func (p *Parser) parseRoleRenamePair(_ Pos) (*RoleRenamePair, error) {
 	}
 	return roleRenamePair, nil
 }
+
+func (p *Parser) parseRoleRenamePairs(_ Pos) ([]*RoleRenamePair, error) {
+	roleRenamePairs := make([]*RoleRenamePair, 0)
+	for {
+		roleRenamePair, err := p.parseRoleRenamePair(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		roleRenamePairs = append(roleRenamePairs, roleRenamePair)
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	return roleRenamePairs, nil
+}
+
+// parseRoleListExpr parses the role list like: {role [,...] | ALL | ANY | NONE} [EXCEPT role [,...]]
+func (p *Parser) parseRoleListExpr(pos Pos) (*RoleListExpr, error) {
+	roleList := &RoleListExpr{ListPos: pos}
+	switch {
+	case p.matchKeyword(KeywordAll), p.matchKeyword(KeywordAny), p.matchKeyword(KeywordNone):
+		lastToken := p.last()
+		_ = p.lexer.consumeToken()
+		roleList.Keyword = strings.ToUpper(lastToken.String)
+		roleList.ListEnd = lastToken.End
+	default:
+		names, err := p.parseRoleNames(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		roleList.Names = names
+		roleList.ListEnd = names[len(names)-1].End()
+	}
+	if p.tryConsumeKeyword(KeywordExcept) != nil {
+		except, err := p.parseRoleNames(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		roleList.Except = except
+		roleList.ListEnd = except[len(except)-1].End()
+	}
+	return roleList, nil
+}
+
+// NOT IDENTIFIED | [ADD] IDENTIFIED [WITH] method [, ...]
+func (p *Parser) parseAuthenticationExpr(pos Pos) (*AuthenticationExpr, error) {
+	if p.tryConsumeKeyword(KeywordNot) != nil {
+		lastToken := p.last()
+		if err := p.consumeKeyword(KeywordIdentified); err != nil {
+			return nil, err
+		}
+		return &AuthenticationExpr{
+			AuthPos:       pos,
+			AuthEnd:       lastToken.End,
+			NotIdentified: true,
+		}, nil
+	}
+
+	isAdd := p.tryConsumeKeyword(KeywordAdd) != nil
+	lastToken := p.last()
+	if err := p.consumeKeyword(KeywordIdentified); err != nil {
+		return nil, err
+	}
+	auth := &AuthenticationExpr{
+		AuthPos: pos,
+		AuthEnd: lastToken.End,
+		IsAdd:   isAdd,
+	}
+	for {
+		method, err := p.parseAuthenticationMethod(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		auth.Methods = append(auth.Methods, method)
+		auth.AuthEnd = method.End()
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	return auth, nil
+}
+
+// [WITH method] [BY 'password' | SERVER 'server' | REALM 'realm' | CN 'name']
+func (p *Parser) parseAuthenticationMethod(pos Pos) (*AuthenticationMethod, error) {
+	method := &AuthenticationMethod{MethodPos: pos}
+	var err error
+	withToken := p.tryConsumeKeyword(KeywordWith)
+	if withToken != nil {
+		method.MethodPos = p.Pos()
+	}
+	if withToken != nil || (p.matchTokenKind(TokenIdent) && !p.matchKeyword(KeywordBy)) {
+		method.Method, err = p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+		method.MethodEnd = method.Method.NameEnd
+	}
+
+	var value *StringLiteral
+	switch {
+	case p.tryConsumeKeyword(KeywordBy) != nil:
+		method.Password, err = p.parseString(p.Pos())
+		value = method.Password
+	case p.tryConsumeKeyword(KeywordServer) != nil:
+		method.Server, err = p.parseString(p.Pos())
+		value = method.Server
+	case p.tryConsumeKeyword(KeywordRealm) != nil:
+		method.Realm, err = p.parseString(p.Pos())
+		value = method.Realm
+	case p.matchTokenKind(TokenIdent) && strings.EqualFold(p.last().String, "CN"):
+		_ = p.lexer.consumeToken()
+		method.CommonName, err = p.parseString(p.Pos())
+		value = method.CommonName
+	}
+	if err != nil {
+		return nil, err
+	}
+	if value != nil {
+		method.MethodEnd = value.End()
+	}
+	if method.Method == nil && value == nil {
+		return nil, fmt.Errorf("expected authentication method, but got %q", p.lastTokenKind())
+	}
+	return method, nil
+}
+
+// [ADD|DROP] HOST {LOCAL | NAME 'name' | REGEXP 'name_regexp' | IP 'address' | LIKE 'pattern'} [,...] | ANY | NONE
+func (p *Parser) parseHostExpr(pos Pos, modifier string) (*HostExpr, error) {
+	if err := p.consumeKeyword(KeywordHost); err != nil {
+		return nil, err
+	}
+	items := make([]*HostItem, 0)
+	for {
+		item, err := p.parseHostItem(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	return &HostExpr{
+		HostPos:  pos,
+		HostEnd:  items[len(items)-1].End(),
+		Modifier: modifier,
+		Items:    items,
+	}, nil
+}
+
+func (p *Parser) parseHostItem(pos Pos) (*HostItem, error) {
+	if !p.matchTokenKind(TokenIdent) {
+		return nil, fmt.Errorf("expected LOCAL|ANY|NONE|NAME|REGEXP|IP|LIKE, but got %q", p.lastTokenKind())
+	}
+	lastToken := p.last()
+	kind := strings.ToUpper(lastToken.String)
+	switch kind {
+	case "LOCAL", "ANY", "NONE":
+		_ = p.lexer.consumeToken()
+		return &HostItem{
+			ItemPos: pos,
+			ItemEnd: lastToken.End,
+			Kind:    kind,
+		}, nil
+	case "NAME", "REGEXP", "IP", "LIKE":
+		_ = p.lexer.consumeToken()
+	default:
+		return nil, fmt.Errorf("expected LOCAL|ANY|NONE|NAME|REGEXP|IP|LIKE, but got %q", lastToken.String)
+	}
+
+	values := make([]*StringLiteral, 0)
+	for {
+		value, err := p.parseString(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		// the values of the same kind could be separated by comma, e.g. IP '127.0.0.1', '::1'
+		if !p.matchTokenKind(",") {
+			break
+		}
+		if nextToken, err := p.lexer.peekToken(); err != nil || nextToken == nil || nextToken.Kind != TokenString {
+			break
+		}
+		_ = p.lexer.consumeToken()
+	}
+	return &HostItem{
+		ItemPos: pos,
+		ItemEnd: values[len(values)-1].End(),
+		Kind:    kind,
+		Values:  values,
+	}, nil
+}
+
+func (p *Parser) parseCreateUser(pos Pos) (*CreateUser, error) {
+	if err := p.consumeKeyword(KeywordUser); err != nil {
+		return nil, err
+	}
+
+	ifNotExists, orReplace, err := p.tryParseIfNotExistsOrReplace()
+	if err != nil {
+		return nil, err
+	}
+
+	userNames, err := p.parseRoleNames(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	createUser := &CreateUser{
+		CreatePos:    pos,
+		StatementEnd: userNames[len(userNames)-1].End(),
+		IfNotExists:  ifNotExists,
+		OrReplace:    orReplace,
+		UserNames:    userNames,
+	}
+
+	for {
+		switch {
+		case p.matchKeyword(KeywordNot), p.matchKeyword(KeywordIdentified):
+			createUser.Authentication, err = p.parseAuthenticationExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			createUser.StatementEnd = createUser.Authentication.End()
+		case p.matchKeyword(KeywordHost):
+			createUser.Hosts, err = p.parseHostExpr(p.Pos(), "")
+			if err != nil {
+				return nil, err
+			}
+			createUser.StatementEnd = createUser.Hosts.End()
+		case p.tryConsumeKeyword(KeywordValid) != nil:
+			if err := p.consumeKeyword(KeywordUntil); err != nil {
+				return nil, err
+			}
+			createUser.ValidUntil, err = p.parseString(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			createUser.StatementEnd = createUser.ValidUntil.End()
+		case p.tryConsumeKeyword(KeywordIn) != nil:
+			createUser.AccessStorageType, err = p.parseIdent()
+			if err != nil {
+				return nil, err
+			}
+			createUser.StatementEnd = createUser.AccessStorageType.NameEnd
+		case p.tryConsumeKeyword(KeywordDefault) != nil:
+			switch {
+			case p.tryConsumeKeyword(KeywordRole) != nil:
+				createUser.DefaultRole, err = p.parseRoleListExpr(p.Pos())
+				if err != nil {
+					return nil, err
+				}
+				createUser.StatementEnd = createUser.DefaultRole.End()
+			case p.tryConsumeKeyword(KeywordDatabase) != nil:
+				createUser.DefaultDatabase, err = p.parseIdent()
+				if err != nil {
+					return nil, err
+				}
+				createUser.StatementEnd = createUser.DefaultDatabase.NameEnd
+			default:
+				return nil, fmt.Errorf("expected ROLE|DATABASE, but got %q", p.lastTokenKind())
+			}
+		case p.tryConsumeKeyword(KeywordGrantees) != nil:
+			createUser.Grantees, err = p.parseRoleListExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			createUser.StatementEnd = createUser.Grantees.End()
+		case p.tryConsumeKeyword(KeywordSettings) != nil:
+			createUser.Settings, err = p.parseRoleSettings(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			if len(createUser.Settings) > 0 {
+				createUser.StatementEnd = createUser.Settings[len(createUser.Settings)-1].End()
+			}
+		default:
+			return createUser, nil
+		}
+	}
+}
+
+func (p *Parser) parseAlterUser(pos Pos) (*AlterUser, error) {
+	if err := p.consumeKeyword(KeywordUser); err != nil {
+		return nil, err
+	}
+
+	ifExists, err := p.tryParseIfExists()
+	if err != nil {
+		return nil, err
+	}
+
+	userRenamePairs, err := p.parseRoleRenamePairs(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	alterUser := &AlterUser{
+		AlterPos:        pos,
+		StatementEnd:    userRenamePairs[len(userRenamePairs)-1].End(),
+		IfExists:        ifExists,
+		UserRenamePairs: userRenamePairs,
+	}
+
+	for {
+		switch {
+		case p.matchKeyword(KeywordNot), p.matchKeyword(KeywordIdentified), p.matchAddIdentified():
+			alterUser.Authentication, err = p.parseAuthenticationExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			alterUser.StatementEnd = alterUser.Authentication.End()
+		case p.tryConsumeKeyword(KeywordReset) != nil:
+			for _, word := range []string{"AUTHENTICATION", "METHODS", KeywordTo, "NEW"} {
+				if p.last() == nil || !strings.EqualFold(p.last().String, word) {
+					return nil, fmt.Errorf("expected RESET AUTHENTICATION METHODS TO NEW, but got %q", p.lastTokenKind())
+				}
+				alterUser.StatementEnd = p.last().End
+				_ = p.lexer.consumeToken()
+			}
+			alterUser.ResetAuth = true
+		case p.matchKeyword(KeywordHost), p.matchKeyword(KeywordAdd), p.matchKeyword(KeywordDrop):
+			hostPos := p.Pos()
+			var modifier string
+			if !p.matchKeyword(KeywordHost) {
+				modifier = strings.ToUpper(p.last().String)
+				_ = p.lexer.consumeToken()
+			}
+			host, err := p.parseHostExpr(hostPos, modifier)
+			if err != nil {
+				return nil, err
+			}
+			alterUser.Hosts = append(alterUser.Hosts, host)
+			alterUser.StatementEnd = host.End()
+		case p.tryConsumeKeyword(KeywordValid) != nil:
+			if err := p.consumeKeyword(KeywordUntil); err != nil {
+				return nil, err
+			}
+			alterUser.ValidUntil, err = p.parseString(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			alterUser.StatementEnd = alterUser.ValidUntil.End()
+		case p.tryConsumeKeyword(KeywordDefault) != nil:
+			switch {
+			case p.tryConsumeKeyword(KeywordRole) != nil:
+				alterUser.DefaultRole, err = p.parseRoleListExpr(p.Pos())
+				if err != nil {
+					return nil, err
+				}
+				alterUser.StatementEnd = alterUser.DefaultRole.End()
+			case p.tryConsumeKeyword(KeywordDatabase) != nil:
+				alterUser.DefaultDatabase, err = p.parseIdent()
+				if err != nil {
+					return nil, err
+				}
+				alterUser.StatementEnd = alterUser.DefaultDatabase.NameEnd
+			default:
+				return nil, fmt.Errorf("expected ROLE|DATABASE, but got %q", p.lastTokenKind())
+			}
+		case p.tryConsumeKeyword(KeywordGrantees) != nil:
+			alterUser.Grantees, err = p.parseRoleListExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			alterUser.StatementEnd = alterUser.Grantees.End()
+		case p.tryConsumeKeyword(KeywordSettings) != nil:
+			alterUser.Settings, err = p.parseRoleSettings(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			if len(alterUser.Settings) > 0 {
+				alterUser.StatementEnd = alterUser.Settings[len(alterUser.Settings)-1].End()
+			}
+		default:
+			return alterUser, nil
+		}
+	}
+}
+
+// matchAddIdentified reports whether the current ADD keyword starts ADD IDENTIFIED rather than ADD HOST.
+func (p *Parser) matchAddIdentified() bool {
+	if !p.matchKeyword(KeywordAdd) {
+		return false
+	}
+	nextToken, err := p.lexer.peekToken()
+	return err == nil && nextToken != nil && strings.EqualFold(nextToken.String, KeywordIdentified)
+}
+
+// KEYED BY key [,...]
+func (p *Parser) parseQuotaKeys(_ Pos) ([]*Ident, error) {
+	if err := p.consumeKeyword(KeywordKeyed); err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordBy); err != nil {
+		return nil, err
+	}
+	return p.parsePrivilegeRoles(p.Pos())
+}
+
+// FOR [RANDOMIZED] INTERVAL number unit {MAX resource = number [,...] | NO LIMITS | TRACKING ONLY}
+func (p *Parser) parseQuotaIntervalExpr(pos Pos) (*QuotaIntervalExpr, error) {
+	if err := p.consumeKeyword(KeywordFor); err != nil {
+		return nil, err
+	}
+	quotaInterval := &QuotaIntervalExpr{ForPos: pos}
+	quotaInterval.Randomized = p.tryConsumeKeyword(KeywordRandomized) != nil
+	interval, err := p.parseColumnExprInterval(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	quotaInterval.Interval = interval.(*IntervalExpr)
+
+	switch {
+	case p.tryConsumeKeyword(KeywordNo) != nil:
+		lastToken := p.last()
+		if err := p.consumeKeyword(KeywordLimits); err != nil {
+			return nil, err
+		}
+		quotaInterval.NoLimits = true
+		quotaInterval.IntervalEnd = lastToken.End
+	case p.tryConsumeKeyword(KeywordTracking) != nil:
+		lastToken := p.last()
+		if err := p.consumeKeyword(KeywordOnly); err != nil {
+			return nil, err
+		}
+		quotaInterval.TrackingOnly = true
+		quotaInterval.IntervalEnd = lastToken.End
+	case p.matchKeyword(KeywordMax):
+		limits, err := p.parseQuotaLimits(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		quotaInterval.Limits = limits
+		quotaInterval.IntervalEnd = limits[len(limits)-1].End()
+	default:
+		return nil, fmt.Errorf("expected MAX|NO LIMITS|TRACKING ONLY, but got %q", p.lastTokenKind())
+	}
+	return quotaInterval, nil
+}
+
+func (p *Parser) parseQuotaLimits(_ Pos) ([]*QuotaLimitExpr, error) {
+	if err := p.consumeKeyword(KeywordMax); err != nil {
+		return nil, err
+	}
+	limits := make([]*QuotaLimitExpr, 0)
+	for {
+		name, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.consumeTokenKind("="); err != nil {
+			return nil, err
+		}
+		value, err := p.parseNumber(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, &QuotaLimitExpr{
+			Name:  name,
+			Value: value,
+		})
+
+		// the comma is also used to separate the intervals
+		if !p.matchTokenKind(",") {
+			break
+		}
+		if nextToken, err := p.lexer.peekToken(); err != nil || nextToken == nil ||
+			(nextToken.Kind == TokenKeyword && strings.EqualFold(nextToken.String, KeywordFor)) {
+			break
+		}
+		_ = p.lexer.consumeToken()
+		_ = p.tryConsumeKeyword(KeywordMax)
+	}
+	return limits, nil
+}
+
+func (p *Parser) parseQuotaIntervals(_ Pos) ([]*QuotaIntervalExpr, error) {
+	intervals := make([]*QuotaIntervalExpr, 0)
+	for {
+		interval, err := p.parseQuotaIntervalExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		intervals = append(intervals, interval)
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	return intervals, nil
+}
+
+func (p *Parser) parseCreateQuota(pos Pos) (*CreateQuota, error) {
+	if err := p.consumeKeyword(KeywordQuota); err != nil {
+		return nil, err
+	}
+
+	ifNotExists, orReplace, err := p.tryParseIfNotExistsOrReplace()
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := p.parseRoleNames(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	createQuota := &CreateQuota{
+		CreatePos:    pos,
+		StatementEnd: names[len(names)-1].End(),
+		IfNotExists:  ifNotExists,
+		OrReplace:    orReplace,
+		Names:        names,
+	}
+
+	for {
+		switch {
+		case p.tryConsumeKeyword(KeywordIn) != nil:
+			createQuota.AccessStorageType, err = p.parseIdent()
+			if err != nil {
+				return nil, err
+			}
+			createQuota.StatementEnd = createQuota.AccessStorageType.NameEnd
+		case p.tryConsumeKeyword(KeywordNot) != nil:
+			lastToken := p.last()
+			if err := p.consumeKeyword(KeywordKeyed); err != nil {
+				return nil, err
+			}
+			createQuota.NotKeyed = true
+			createQuota.StatementEnd = lastToken.End
+		case p.matchKeyword(KeywordKeyed):
+			createQuota.KeyedBy, err = p.parseQuotaKeys(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			createQuota.StatementEnd = createQuota.KeyedBy[len(createQuota.KeyedBy)-1].NameEnd
+		case p.matchKeyword(KeywordFor):
+			intervals, err := p.parseQuotaIntervals(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			createQuota.Intervals = append(createQuota.Intervals, intervals...)
+			createQuota.StatementEnd = createQuota.Intervals[len(createQuota.Intervals)-1].End()
+		case p.tryConsumeKeyword(KeywordTo) != nil:
+			createQuota.To, err = p.parseRoleListExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			createQuota.StatementEnd = createQuota.To.End()
+		default:
+			return createQuota, nil
+		}
+	}
+}
+
+func (p *Parser) parseAlterQuota(pos Pos) (*AlterQuota, error) {
+	if err := p.consumeKeyword(KeywordQuota); err != nil {
+		return nil, err
+	}
+
+	ifExists, err := p.tryParseIfExists()
+	if err != nil {
+		return nil, err
+	}
+
+	quotaRenamePairs, err := p.parseRoleRenamePairs(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	alterQuota := &AlterQuota{
+		AlterPos:         pos,
+		StatementEnd:     quotaRenamePairs[len(quotaRenamePairs)-1].End(),
+		IfExists:         ifExists,
+		QuotaRenamePairs: quotaRenamePairs,
+	}
+
+	for {
+		switch {
+		case p.tryConsumeKeyword(KeywordNot) != nil:
+			lastToken := p.last()
+			if err := p.consumeKeyword(KeywordKeyed); err != nil {
+				return nil, err
+			}
+			alterQuota.NotKeyed = true
+			alterQuota.StatementEnd = lastToken.End
+		case p.matchKeyword(KeywordKeyed):
+			alterQuota.KeyedBy, err = p.parseQuotaKeys(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			alterQuota.StatementEnd = alterQuota.KeyedBy[len(alterQuota.KeyedBy)-1].NameEnd
+		case p.matchKeyword(KeywordFor):
+			intervals, err := p.parseQuotaIntervals(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			alterQuota.Intervals = append(alterQuota.Intervals, intervals...)
+			alterQuota.StatementEnd = alterQuota.Intervals[len(alterQuota.Intervals)-1].End()
+		case p.tryConsumeKeyword(KeywordTo) != nil:
+			alterQuota.To, err = p.parseRoleListExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			alterQuota.StatementEnd = alterQuota.To.End()
+		default:
+			return alterQuota, nil
+		}
+	}
+}
+
+// parseRowPolicyNames parses the row policy names like: name [ON CLUSTER cluster] ON [db.]table [,...],
+// the names before the same ON clause share the table, e.g. p1, p2 ON db.table.
+func (p *Parser) parseRowPolicyNames(_ Pos, allowRename bool) ([]*RowPolicyName, error) {
+	policies := make([]*RowPolicyName, 0)
+	pending := 0
+	for {
+		name, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+		policy := &RowPolicyName{Name: name}
+		if p.matchKeyword(KeywordOn) {
+			if nextToken, err := p.lexer.peekToken(); err == nil && nextToken != nil &&
+				nextToken.Kind == TokenKeyword && strings.EqualFold(nextToken.String, KeywordCluster) {
+				policy.OnCluster, err = p.tryParseOnCluster(p.Pos())
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		policies = append(policies, policy)
+
+		if p.matchKeyword(KeywordOn) {
+			onPos := p.Pos()
+			_ = p.lexer.consumeToken()
+			on, err := p.parseGrantSource(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			for _, pendingPolicy := range policies[pending:] {
+				pendingPolicy.OnPos = onPos
+				pendingPolicy.On = on
+			}
+			pending = len(policies)
+			if allowRename && p.tryConsumeKeyword(KeywordRename) != nil {
+				if err := p.consumeKeyword(KeywordTo); err != nil {
+					return nil, err
+				}
+				policy.NewName, err = p.parseIdent()
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	if pending != len(policies) {
+		return nil, fmt.Errorf("expected ON, but got %q", p.lastTokenKind())
+	}
+	return policies, nil
+}
+
+func (p *Parser) parseCreateRowPolicy(pos Pos) (*CreateRowPolicy, error) {
+	_ = p.tryConsumeKeyword(KeywordRow)
+	if err := p.consumeKeyword(KeywordPolicy); err != nil {
+		return nil, err
+	}
+
+	ifNotExists, orReplace, err := p.tryParseIfNotExistsOrReplace()
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := p.parseRowPolicyNames(p.Pos(), false)
+	if err != nil {
+		return nil, err
+	}
+	createRowPolicy := &CreateRowPolicy{
+		CreatePos:    pos,
+		StatementEnd: policies[len(policies)-1].End(),
+		IfNotExists:  ifNotExists,
+		OrReplace:    orReplace,
+		Policies:     policies,
+	}
+
+	for {
+		switch {
+		case p.tryConsumeKeyword(KeywordIn) != nil:
+			createRowPolicy.AccessStorageType, err = p.parseIdent()
+			if err != nil {
+				return nil, err
+			}
+			createRowPolicy.StatementEnd = createRowPolicy.AccessStorageType.NameEnd
+		case p.tryConsumeKeyword(KeywordFor) != nil:
+			lastToken := p.last()
+			if err := p.consumeKeyword(KeywordSelect); err != nil {
+				return nil, err
+			}
+			createRowPolicy.ForSelect = true
+			createRowPolicy.StatementEnd = lastToken.End
+		case p.tryConsumeKeyword(KeywordUsing) != nil:
+			// parse the condition without alias since AS is used by the policy kind
+			createRowPolicy.Using, err = p.parseOrExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			createRowPolicy.StatementEnd = createRowPolicy.Using.End()
+		case p.tryConsumeKeyword(KeywordAs) != nil:
+			lastToken := p.last()
+			createRowPolicy.Kind, err = p.parseRowPolicyKind()
+			if err != nil {
+				return nil, err
+			}
+			createRowPolicy.StatementEnd = lastToken.End
+		case p.tryConsumeKeyword(KeywordTo) != nil:
+			createRowPolicy.To, err = p.parseRoleListExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			createRowPolicy.StatementEnd = createRowPolicy.To.End()
+		default:
+			return createRowPolicy, nil
+		}
+	}
+}
+
+func (p *Parser) parseAlterRowPolicy(pos Pos) (*AlterRowPolicy, error) {
+	_ = p.tryConsumeKeyword(KeywordRow)
+	if err := p.consumeKeyword(KeywordPolicy); err != nil {
+		return nil, err
+	}
+
+	ifExists, err := p.tryParseIfExists()
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := p.parseRowPolicyNames(p.Pos(), true)
+	if err != nil {
+		return nil, err
+	}
+	alterRowPolicy := &AlterRowPolicy{
+		AlterPos:     pos,
+		StatementEnd: policies[len(policies)-1].End(),
+		IfExists:     ifExists,
+		Policies:     policies,
+	}
+
+	for {
+		switch {
+		case p.tryConsumeKeyword(KeywordFor) != nil:
+			lastToken := p.last()
+			if err := p.consumeKeyword(KeywordSelect); err != nil {
+				return nil, err
+			}
+			alterRowPolicy.ForSelect = true
+			alterRowPolicy.StatementEnd = lastToken.End
+		case p.tryConsumeKeyword(KeywordUsing) != nil:
+			// parse the condition without alias since AS is used by the policy kind
+			alterRowPolicy.Using, err = p.parseOrExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			alterRowPolicy.StatementEnd = alterRowPolicy.Using.End()
+		case p.tryConsumeKeyword(KeywordAs) != nil:
+			lastToken := p.last()
+			alterRowPolicy.Kind, err = p.parseRowPolicyKind()
+			if err != nil {
+				return nil, err
+			}
+			alterRowPolicy.StatementEnd = lastToken.End
+		case p.tryConsumeKeyword(KeywordTo) != nil:
+			alterRowPolicy.To, err = p.parseRoleListExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			alterRowPolicy.StatementEnd = alterRowPolicy.To.End()
+		default:
+			return alterRowPolicy, nil
+		}
+	}
+}
+
+func (p *Parser) parseRowPolicyKind() (string, error) {
+	switch {
+	case p.tryConsumeKeyword(KeywordPermissive) != nil:
+		return KeywordPermissive, nil
+	case p.tryConsumeKeyword(KeywordRestrictive) != nil:
+		return KeywordRestrictive, nil
+	default:
+		return "", fmt.Errorf("expected PERMISSIVE|RESTRICTIVE, but got %q", p.lastTokenKind())
+	}
+}
+
+func (p *Parser) parseCreateSettingsProfile(pos Pos) (*CreateSettingsProfile, error) {
+	_ = p.tryConsumeKeyword(KeywordSettings)
+	if err := p.consumeKeyword(KeywordProfile); err != nil {
+		return nil, err
+	}
+
+	ifNotExists, orReplace, err := p.tryParseIfNotExistsOrReplace()
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := p.parseRoleNames(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	createSettingsProfile := &CreateSettingsProfile{
+		CreatePos:    pos,
+		StatementEnd: names[len(names)-1].End(),
+		IfNotExists:  ifNotExists,
+		OrReplace:    orReplace,
+		Names:        names,
+	}
+
+	for {
+		switch {
+		case p.tryConsumeKeyword(KeywordIn) != nil:
+			createSettingsProfile.AccessStorageType, err = p.parseIdent()
+			if err != nil {
+				return nil, err
+			}
+			createSettingsProfile.StatementEnd = createSettingsProfile.AccessStorageType.NameEnd
+		case p.tryConsumeKeyword(KeywordSettings) != nil:
+			createSettingsProfile.Settings, err = p.parseRoleSettings(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			if len(createSettingsProfile.Settings) > 0 {
+				createSettingsProfile.StatementEnd = createSettingsProfile.Settings[len(createSettingsProfile.Settings)-1].End()
+			}
+		case p.tryConsumeKeyword(KeywordTo) != nil:
+			createSettingsProfile.To, err = p.parseRoleListExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			createSettingsProfile.StatementEnd = createSettingsProfile.To.End()
+		default:
+			return createSettingsProfile, nil
+		}
+	}
+}
+
+func (p *Parser) parseAlterSettingsProfile(pos Pos) (*AlterSettingsProfile, error) {
+	_ = p.tryConsumeKeyword(KeywordSettings)
+	if err := p.consumeKeyword(KeywordProfile); err != nil {
+		return nil, err
+	}
+
+	ifExists, err := p.tryParseIfExists()
+	if err != nil {
+		return nil, err
+	}
+
+	profileRenamePairs, err := p.parseRoleRenamePairs(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	alterSettingsProfile := &AlterSettingsProfile{
+		AlterPos:           pos,
+		StatementEnd:       profileRenamePairs[len(profileRenamePairs)-1].End(),
+		IfExists:           ifExists,
+		ProfileRenamePairs: profileRenamePairs,
+	}
+
+	for {
+		switch {
+		case p.tryConsumeKeyword(KeywordSettings) != nil:
+			alterSettingsProfile.Settings, err = p.parseRoleSettings(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			if len(alterSettingsProfile.Settings) > 0 {
+				alterSettingsProfile.StatementEnd = alterSettingsProfile.Settings[len(alterSettingsProfile.Settings)-1].End()
+			}
+		case p.tryConsumeKeyword(KeywordTo) != nil:
+			alterSettingsProfile.To, err = p.parseRoleListExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			alterSettingsProfile.StatementEnd = alterSettingsProfile.To.End()
+		default:
+			return alterSettingsProfile, nil
+		}
+	}
+}
@@ -3,6 +3,7 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 func (p *Parser) parseAlterTable(pos Pos) (*AlterTable, error) {
@@ -49,9 +50,25 @@ func (p *Parser) parseAlterTable(pos Pos) (*AlterTable, error) {
 			alterExpr, err = p.parseAlterTableModify(p.Pos())
 		case p.matchKeyword(KeywordReplace):
 			alterExpr, err = p.parseAlterTableReplacePartition(p.Pos())
+		case p.matchKeyword(KeywordUpdate):
+			alterExpr, err = p.parseAlterTableUpdate(p.Pos())
+		case p.matchKeyword(KeywordDelete):
+			alterExpr, err = p.parseAlterTableDelete(p.Pos())
+		case p.matchKeyword(KeywordMove):
+			alterExpr, err = p.parseAlterTableMovePartition(p.Pos())
+		case p.matchKeyword(KeywordFetch):
+			alterExpr, err = p.parseAlterTableFetchPartition(p.Pos())
+		case p.matchKeyword(KeywordMaterialize):
+			alterExpr, err = p.parseAlterTableMaterialize(p.Pos())
+		case p.matchKeyword(KeywordReset):
+			alterExpr, err = p.parseAlterTableResetSetting(p.Pos())
+		case p.matchKeyword(KeywordComment):
+			alterExpr, err = p.parseAlterTableCommentColumn(p.Pos())
+		case p.matchKeyword(KeywordUnfreeze):
+			alterExpr, err = p.parseAlterTableUnfreeze(p.Pos())
 
 		default:
-			return nil, errors.New("expected token: ADD|DROP|ATTACH|DETACH|FREEZE|REMOVE|CLEAR")
+			return nil, errors.New("expected token: ADD|DROP|ATTACH|DETACH|FREEZE|REMOVE|CLEAR|MODIFY|REPLACE|UPDATE|DELETE|MOVE|FETCH|MATERIALIZE|RESET|COMMENT|UNFREEZE")
 		}
 		if err != nil {
 			return nil, err
@@ -79,8 +96,12 @@ func (p *Parser) parseAlterTableAdd(pos Pos) (AlterTableExpr, error) {
 		return p.parseAlterTableAddColumn(pos)
 	case p.matchKeyword(KeywordIndex):
 		return p.parseAlterTableAddIndex(pos)
+	case p.matchKeyword(KeywordProjection):
+		return p.parseAlterTableAddProjection(pos)
+	case p.matchKeyword(KeywordConstraint):
+		return p.parseAlterTableAddConstraint(pos)
 	default:
-		return nil, errors.New("expected token: COLUMN|INDEX")
+		return nil, errors.New("expected token: COLUMN|INDEX|PROJECTION|CONSTRAINT")
 	}
 }
 
@@ -153,7 +174,11 @@ func (p *Parser) parseTableIndex(pos Pos) (*TableIndex, error) {
 	if err != nil {
 		return nil, err
 	}
+	return p.parseTableIndexDefinition(pos, name)
+}
 
+// parseTableIndexDefinition parses the part of the index after its name: expr TYPE type [GRANULARITY n]
+func (p *Parser) parseTableIndexDefinition(pos Pos, name *NestedIdentifier) (*TableIndex, error) {
 	columnExpr, err := p.parseColumnsExpr(p.Pos())
 	if err != nil {
 		return nil, err
@@ -162,17 +187,18 @@ func (p *Parser) parseTableIndex(pos Pos) (*TableIndex, error) {
 	if err := p.consumeKeyword(KeywordType); err != nil {
 		return nil, err
 	}
-	columnType, err := p.parseColumnType(p.Pos())
+	// the index type can have the parameters, like bloom_filter(0.01)
+	columnType, err := p.parseIdentOrFunction(p.Pos())
 	if err != nil {
 		return nil, err
 	}
 
-	if err := p.consumeKeyword(KeywordGranularity); err != nil {
-		return nil, err
-	}
-	granularity, err := p.parseDecimal(p.Pos())
-	if err != nil {
-		return nil, err
+	var granularity *NumberLiteral
+	if p.tryConsumeKeyword(KeywordGranularity) != nil {
+		granularity, err = p.parseDecimal(p.Pos())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &TableIndex{
@@ -199,8 +225,12 @@ func (p *Parser) parseAlterTableDrop(pos Pos) (AlterTableExpr, error) {
 		return p.parseAlterTableDetachPartition(pos)
 	case p.matchKeyword(KeywordPartition):
 		return p.parseAlterTableDropPartition(pos)
+	case p.matchKeyword(KeywordProjection):
+		return p.parseAlterTableDropProjection(pos)
+	case p.matchKeyword(KeywordConstraint):
+		return p.parseAlterTableDropConstraint(pos)
 	default:
-		return nil, errors.New("expected keyword: COLUMN|INDEX|DETACH")
+		return nil, errors.New("expected keyword: COLUMN|INDEX|DETACHED|PARTITION|PROJECTION|CONSTRAINT")
 	}
 }
 
@@ -405,7 +435,8 @@ func (p *Parser) parseAlterTableClear(pos Pos) (AlterTableExpr, error) {
 		return p.parseAlterTableClearColumn(pos)
 	case p.matchKeyword(KeywordIndex):
 		return p.parseAlterTableClearIndex(pos)
-
+	case p.matchKeyword(KeywordProjection):
+		return p.parseAlterTableClearProjection(pos)
 	default:
 		return nil, errors.New("expected token: COLUMN|INDEX|PROJECTION")
 	}
@@ -531,19 +562,62 @@ func (p *Parser) parseAlterTableModify(pos Pos) (AlterTableExpr, error) {
 	case p.matchKeyword(KeywordColumn):
 		return p.parseAlterTableModifyColumn(pos)
 	case p.matchKeyword(KeywordTtl):
-		_ = p.lexer.consumeToken()
-		ttlExpr, err := p.parseTTLExpr(p.Pos())
+		ttlExprList, err := p.tryParseTTLExprList(p.Pos())
 		if err != nil {
 			return nil, err
 		}
 		return &AlterTableModifyTTL{
 			ModifyPos:    pos,
-			StatementEnd: ttlExpr.End(),
-			TTL:          ttlExpr,
+			StatementEnd: ttlExprList.End(),
+			TTL:          ttlExprList,
+		}, nil
+	case p.matchKeyword(KeywordOrder):
+		orderBy, err := p.tryParseOrderByExprList(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		return &AlterTableModifyOrderBy{
+			ModifyPos: pos,
+			OrderBy:   orderBy,
+		}, nil
+	case p.tryConsumeKeyword(KeywordSetting) != nil:
+		settings := make([]*SettingsExpr, 0)
+		for {
+			setting, err := p.parseSettingsExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			settings = append(settings, setting)
+			if p.matchAlterClauseAfterComma() || p.tryConsumeTokenKind(",") == nil {
+				break
+			}
+		}
+		return &AlterTableModifySetting{
+			ModifyPos:    pos,
+			StatementEnd: settings[len(settings)-1].End(),
+			Settings:     settings,
+		}, nil
+	case p.tryConsumeKeyword(KeywordComment) != nil:
+		comment, err := p.parseString(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		return &AlterTableModifyComment{
+			ModifyPos: pos,
+			Comment:   comment,
+		}, nil
+	case p.tryConsumeKeyword(KeywordQuery) != nil:
+		selectExpr, err := p.parseSelectQuery(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		return &AlterTableModifyQuery{
+			ModifyPos:  pos,
+			SelectExpr: selectExpr,
 		}, nil
 	default:
-		return nil, fmt.Errorf("expected keyword: COLUMN, but got %q",
-			p.last().String)
+		return nil, fmt.Errorf("expected keyword: COLUMN|TTL|ORDER|SETTING|COMMENT|QUERY, but got %q",
+			p.lastTokenKind())
 	}
 
 }
@@ -627,3 +701,573 @@ func (p *Parser) parseAlterTableReplacePartition(pos Pos) (AlterTableExpr, error
 		Table:      table,
 	}, nil
 }
+
+func (p *Parser) tryParseInPartitionExpr(pos Pos) (*PartitionExpr, error) {
+	if !p.matchInPartition() {
+		return nil, nil // nolint
+	}
+	_ = p.lexer.consumeToken()
+	return p.parsePartitionExpr(pos)
+}
+
+// matchInPartition reports whether the next tokens are IN PARTITION, which is
+// a clause of mutations rather than the IN operator.
+func (p *Parser) matchInPartition() bool {
+	if !p.matchKeyword(KeywordIn) {
+		return false
+	}
+	nextToken, err := p.lexer.peekToken()
+	return err == nil && nextToken != nil &&
+		nextToken.Kind == TokenKeyword && strings.EqualFold(nextToken.String, KeywordPartition)
+}
+
+func (p *Parser) parseUpdateAssignment(_ Pos) (*UpdateAssignment, error) {
+	column, err := p.ParseNestedIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind("="); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateAssignment{
+		Column: column,
+		Expr:   expr,
+	}, nil
+}
+
+func (p *Parser) parseUpdateAssignments(pos Pos) ([]*UpdateAssignment, error) {
+	assignments := make([]*UpdateAssignment, 0)
+	for {
+		assignment, err := p.parseUpdateAssignment(pos)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+		if p.tryConsumeTokenKind(",") == nil {
+			return assignments, nil
+		}
+	}
+}
+
+// Syntax: ALTER TABLE UPDATE column = expr [, ...] [IN PARTITION partition] WHERE filter
+func (p *Parser) parseAlterTableUpdate(pos Pos) (AlterTableExpr, error) {
+	if err := p.consumeKeyword(KeywordUpdate); err != nil {
+		return nil, err
+	}
+	assignments, err := p.parseUpdateAssignments(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	inPartition, err := p.tryParseInPartitionExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordWhere); err != nil {
+		return nil, err
+	}
+	whereExpr, err := p.parseExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &AlterTableUpdate{
+		UpdatePos:   pos,
+		Assignments: assignments,
+		InPartition: inPartition,
+		WhereExpr:   whereExpr,
+	}, nil
+}
+
+// Syntax: ALTER TABLE DELETE [IN PARTITION partition] WHERE filter
+func (p *Parser) parseAlterTableDelete(pos Pos) (AlterTableExpr, error) {
+	if err := p.consumeKeyword(KeywordDelete); err != nil {
+		return nil, err
+	}
+	inPartition, err := p.tryParseInPartitionExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordWhere); err != nil {
+		return nil, err
+	}
+	whereExpr, err := p.parseExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &AlterTableDelete{
+		DeletePos:   pos,
+		InPartition: inPartition,
+		WhereExpr:   whereExpr,
+	}, nil
+}
+
+// parsePartitionOrPart parses the partitionClause or PART STRING_LITERAL of MOVE and FETCH.
+func (p *Parser) parsePartitionOrPart(pos Pos) (*PartitionExpr, *StringLiteral, error) {
+	if p.matchTokenKind(TokenIdent) && strings.EqualFold(p.last().String, "PART") {
+		_ = p.lexer.consumeToken()
+		part, err := p.parseString(p.Pos())
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, part, nil
+	}
+	partitionExpr, err := p.parsePartitionExpr(pos)
+	if err != nil {
+		return nil, nil, err
+	}
+	return partitionExpr, nil, nil
+}
+
+// Syntax: ALTER TABLE MOVE (partitionClause | PART STRING_LITERAL) TO (DISK | VOLUME) STRING_LITERAL
+// Syntax: ALTER TABLE MOVE partitionClause TO TABLE tableIdentifier
+func (p *Parser) parseAlterTableMovePartition(pos Pos) (AlterTableExpr, error) {
+	if err := p.consumeKeyword(KeywordMove); err != nil {
+		return nil, err
+	}
+	partitionExpr, part, err := p.parsePartitionOrPart(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordTo); err != nil {
+		return nil, err
+	}
+
+	alterTable := &AlterTableMovePartition{
+		MovePos:   pos,
+		Partition: partitionExpr,
+		Part:      part,
+	}
+	switch {
+	case p.tryConsumeKeyword(KeywordDisk) != nil:
+		alterTable.ToType = KeywordDisk
+		alterTable.To, err = p.parseString(p.Pos())
+	case p.tryConsumeKeyword(KeywordVolume) != nil:
+		alterTable.ToType = KeywordVolume
+		alterTable.To, err = p.parseString(p.Pos())
+	case part == nil && p.tryConsumeKeyword(KeywordTable) != nil:
+		alterTable.ToType = KeywordTable
+		alterTable.ToTable, err = p.parseTableIdentifier(p.Pos())
+	default:
+		return nil, fmt.Errorf("expected keyword: DISK|VOLUME|TABLE, but got %q", p.lastTokenKind())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return alterTable, nil
+}
+
+// Syntax: ALTER TABLE FETCH (partitionClause | PART STRING_LITERAL) FROM STRING_LITERAL
+func (p *Parser) parseAlterTableFetchPartition(pos Pos) (AlterTableExpr, error) {
+	if err := p.consumeKeyword(KeywordFetch); err != nil {
+		return nil, err
+	}
+	partitionExpr, part, err := p.parsePartitionOrPart(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordFrom); err != nil {
+		return nil, err
+	}
+	from, err := p.parseString(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &AlterTableFetchPartition{
+		FetchPos:  pos,
+		Partition: partitionExpr,
+		Part:      part,
+		From:      from,
+	}, nil
+}
+
+func (p *Parser) parseAlterTableMaterialize(pos Pos) (AlterTableExpr, error) {
+	if err := p.consumeKeyword(KeywordMaterialize); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p.matchKeyword(KeywordTtl):
+		alterTable := &AlterTableMaterializeTTL{
+			MaterializePos: pos,
+			StatementEnd:   p.last().End,
+		}
+		_ = p.lexer.consumeToken()
+		partitionExpr, err := p.tryParseInPartitionExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		if partitionExpr != nil {
+			alterTable.PartitionExpr = partitionExpr
+			alterTable.StatementEnd = partitionExpr.End()
+		}
+		return alterTable, nil
+	case p.matchKeyword(KeywordIndex), p.matchKeyword(KeywordColumn), p.matchKeyword(KeywordProjection):
+	default:
+		return nil, errors.New("expected token: INDEX|COLUMN|PROJECTION|TTL")
+	}
+
+	kind := p.last()
+	_ = p.lexer.consumeToken()
+	ifExists, err := p.tryParseIfExists()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.ParseNestedIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	statementEnd := name.End()
+	partitionExpr, err := p.tryParseInPartitionExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if partitionExpr != nil {
+		statementEnd = partitionExpr.End()
+	}
+
+	switch strings.ToUpper(kind.String) {
+	case KeywordIndex:
+		return &AlterTableMaterializeIndex{
+			MaterializePos: pos,
+			StatementEnd:   statementEnd,
+			IfExists:       ifExists,
+			IndexName:      name,
+			PartitionExpr:  partitionExpr,
+		}, nil
+	case KeywordColumn:
+		return &AlterTableMaterializeColumn{
+			MaterializePos: pos,
+			StatementEnd:   statementEnd,
+			IfExists:       ifExists,
+			ColumnName:     name,
+			PartitionExpr:  partitionExpr,
+		}, nil
+	default:
+		return &AlterTableMaterializeProjection{
+			MaterializePos: pos,
+			StatementEnd:   statementEnd,
+			IfExists:       ifExists,
+			ProjectionName: name,
+			PartitionExpr:  partitionExpr,
+		}, nil
+	}
+}
+
+// Syntax: projectionName ( SELECT columnExprList (GROUP BY ...)? (ORDER BY ...)? ) (WITH SETTINGS ( settingExprList ))?
+func (p *Parser) parseTableProjection(pos Pos) (*TableProjection, error) {
+	identifier, err := p.ParseNestedIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	selectExpr, err := p.parseProjectionSelect(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	projection := &TableProjection{
+		ProjectionPos: pos,
+		Identifier:    identifier,
+		Select:        selectExpr,
+	}
+	if p.tryConsumeKeyword(KeywordWith) == nil {
+		return projection, nil
+	}
+	settingsPos := p.Pos()
+	if err := p.consumeKeyword(KeywordSettings); err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	settings, err := p.parseSettingsExprList(settingsPos)
+	if err != nil {
+		return nil, err
+	}
+	rightParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	projection.Settings = settings
+	projection.SettingsEnd = rightParen.End
+	return projection, nil
+}
+
+func (p *Parser) parseProjectionSelect(pos Pos) (*ProjectionSelect, error) {
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordSelect); err != nil {
+		return nil, err
+	}
+	columns, err := p.parseColumnExprListWithRoundBracket(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	groupBy, err := p.tryParseGroupByExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	orderBy, err := p.tryParseOrderByExprList(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	rightParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectionSelect{
+		LeftParenPos:  pos,
+		RightParenPos: rightParen.End,
+		SelectColumns: columns,
+		GroupBy:       groupBy,
+		OrderBy:       orderBy,
+	}, nil
+}
+
+// Syntax: ALTER TABLE ADD PROJECTION (IF NOT EXISTS)? tableProjection (AFTER nestedIdentifier)?
+func (p *Parser) parseAlterTableAddProjection(pos Pos) (*AlterTableAddProjection, error) {
+	projectionPos := p.Pos()
+	if err := p.consumeKeyword(KeywordProjection); err != nil {
+		return nil, err
+	}
+	ifNotExists, err := p.tryParseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+	projection, err := p.parseTableProjection(projectionPos)
+	if err != nil {
+		return nil, err
+	}
+	statementEnd := projection.End()
+	after, err := p.tryParseAfterClause()
+	if err != nil {
+		return nil, err
+	}
+	if after != nil {
+		statementEnd = after.End()
+	}
+	return &AlterTableAddProjection{
+		AddPos:          pos,
+		StatementEnd:    statementEnd,
+		IfNotExists:     ifNotExists,
+		TableProjection: projection,
+		After:           after,
+	}, nil
+}
+
+// Syntax: ALTER TABLE DROP PROJECTION (IF EXISTS)? nestedIdentifier
+func (p *Parser) parseAlterTableDropProjection(pos Pos) (AlterTableExpr, error) {
+	if err := p.consumeKeyword(KeywordProjection); err != nil {
+		return nil, err
+	}
+	ifExists, err := p.tryParseIfExists()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.ParseNestedIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &AlterTableDropProjection{
+		DropPos:        pos,
+		IfExists:       ifExists,
+		ProjectionName: name,
+	}, nil
+}
+
+// Syntax: ALTER TABLE CLEAR PROJECTION (IF EXISTS)? nestedIdentifier (IN partitionClause)?
+func (p *Parser) parseAlterTableClearProjection(pos Pos) (AlterTableExpr, error) {
+	if err := p.consumeKeyword(KeywordProjection); err != nil {
+		return nil, err
+	}
+	ifExists, err := p.tryParseIfExists()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.ParseNestedIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	statementEnd := name.End()
+	partitionExpr, err := p.tryParseInPartitionExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if partitionExpr != nil {
+		statementEnd = partitionExpr.End()
+	}
+	return &AlterTableClearProjection{
+		ClearPos:       pos,
+		StatementEnd:   statementEnd,
+		IfExists:       ifExists,
+		ProjectionName: name,
+		PartitionExpr:  partitionExpr,
+	}, nil
+}
+
+// Syntax: ALTER TABLE ADD CONSTRAINT (IF NOT EXISTS)? identifier CHECK columnExpr
+func (p *Parser) parseAlterTableAddConstraint(pos Pos) (AlterTableExpr, error) {
+	constraintPos := p.Pos()
+	if err := p.consumeKeyword(KeywordConstraint); err != nil {
+		return nil, err
+	}
+	ifNotExists, err := p.tryParseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+	constraint, err := p.parseConstraintExpr(constraintPos)
+	if err != nil {
+		return nil, err
+	}
+	return &AlterTableAddConstraint{
+		AddPos:      pos,
+		IfNotExists: ifNotExists,
+		Constraint:  constraint,
+	}, nil
+}
+
+// Syntax: identifier (CHECK | ASSUME) columnExpr
+func (p *Parser) parseConstraintExpr(pos Pos) (*ConstraintExpr, error) {
+	name, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+	if !p.matchKeyword(KeywordCheck) && !p.matchKeyword(KeywordAssume) {
+		return nil, fmt.Errorf("expected CHECK or ASSUME, but got %q", p.lastTokenKind())
+	}
+	kind := strings.ToUpper(p.last().String)
+	_ = p.lexer.consumeToken()
+	expr, err := p.parseExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &ConstraintExpr{
+		ConstraintPos: pos,
+		Constraint:    name,
+		Kind:          kind,
+		Expr:          expr,
+	}, nil
+}
+
+// Syntax: ALTER TABLE DROP CONSTRAINT (IF EXISTS)? identifier
+func (p *Parser) parseAlterTableDropConstraint(pos Pos) (AlterTableExpr, error) {
+	if err := p.consumeKeyword(KeywordConstraint); err != nil {
+		return nil, err
+	}
+	ifExists, err := p.tryParseIfExists()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+	return &AlterTableDropConstraint{
+		DropPos:        pos,
+		IfExists:       ifExists,
+		ConstraintName: name,
+	}, nil
+}
+
+// matchAlterClauseAfterComma reports whether the comma is followed by the next alter clause
+// rather than the next item of the current setting list.
+func (p *Parser) matchAlterClauseAfterComma() bool {
+	if !p.matchTokenKind(",") {
+		return false
+	}
+	nextToken, err := p.lexer.peekToken()
+	if err != nil || nextToken == nil || nextToken.Kind != TokenKeyword {
+		return false
+	}
+	switch strings.ToUpper(nextToken.String) {
+	case KeywordAdd, KeywordDrop, KeywordAttach, KeywordDetach, KeywordFreeze, KeywordRemove,
+		KeywordRename, KeywordClear, KeywordModify, KeywordReplace, KeywordUpdate, KeywordDelete,
+		KeywordMove, KeywordFetch, KeywordMaterialize, KeywordReset, KeywordComment, KeywordUnfreeze:
+		return true
+	}
+	return false
+}
+
+// Syntax: ALTER TABLE RESET SETTING identifier (, identifier)*
+func (p *Parser) parseAlterTableResetSetting(pos Pos) (AlterTableExpr, error) {
+	if err := p.consumeKeyword(KeywordReset); err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordSetting); err != nil {
+		return nil, err
+	}
+	settings := make([]*Ident, 0)
+	for {
+		setting, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, setting)
+		if p.matchAlterClauseAfterComma() || p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	return &AlterTableResetSetting{
+		ResetPos:     pos,
+		StatementEnd: settings[len(settings)-1].End(),
+		Settings:     settings,
+	}, nil
+}
+
+// Syntax: ALTER TABLE COMMENT COLUMN (IF EXISTS)? nestedIdentifier STRING_LITERAL
+func (p *Parser) parseAlterTableCommentColumn(pos Pos) (AlterTableExpr, error) {
+	if err := p.consumeKeyword(KeywordComment); err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordColumn); err != nil {
+		return nil, err
+	}
+	ifExists, err := p.tryParseIfExists()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.ParseNestedIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	comment, err := p.parseString(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &AlterTableCommentColumn{
+		CommentPos: pos,
+		IfExists:   ifExists,
+		ColumnName: name,
+		Comment:    comment,
+	}, nil
+}
+
+// Syntax: ALTER TABLE UNFREEZE partitionClause? WITH NAME STRING_LITERAL
+func (p *Parser) parseAlterTableUnfreeze(pos Pos) (AlterTableExpr, error) {
+	if err := p.consumeKeyword(KeywordUnfreeze); err != nil {
+		return nil, err
+	}
+	partitionExpr, err := p.tryParsePartitionExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordWith); err != nil {
+		return nil, err
+	}
+	// NAME isn't a keyword since it's widely used as a column name
+	if !p.matchTokenKind(TokenIdent) || !strings.EqualFold(p.last().String, "NAME") {
+		return nil, fmt.Errorf("expected NAME, but got %q", p.lastTokenKind())
+	}
+	_ = p.lexer.consumeToken()
+	name, err := p.parseString(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &AlterTableUnfreeze{
+		UnfreezePos: pos,
+		Partition:   partitionExpr,
+		Name:        name,
+	}, nil
+}
@@ -0,0 +1,206 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Syntax: BACKUP element [, ...] [ON CLUSTER cluster] TO engine(...) [SETTINGS ...] [SYNC|ASYNC]
+func (p *Parser) parseBackupExpr(pos Pos) (*BackupExpr, error) {
+	if err := p.consumeKeyword(KeywordBackup); err != nil {
+		return nil, err
+	}
+	backupExpr := &BackupExpr{BackupPos: pos}
+	var err error
+	backupExpr.Elements, backupExpr.OnCluster, err = p.parseBackupElements()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordTo); err != nil {
+		return nil, err
+	}
+	backupExpr.Destination, err = p.parseTableFunctionCall(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	backupExpr.StatementEnd = backupExpr.Destination.End()
+	backupExpr.Settings, backupExpr.Mode, err = p.parseBackupOptions(&backupExpr.StatementEnd)
+	if err != nil {
+		return nil, err
+	}
+	return backupExpr, nil
+}
+
+// Syntax: RESTORE element [, ...] [ON CLUSTER cluster] FROM engine(...) [SETTINGS ...] [SYNC|ASYNC]
+func (p *Parser) parseRestoreExpr(pos Pos) (*RestoreExpr, error) {
+	if err := p.consumeKeyword(KeywordRestore); err != nil {
+		return nil, err
+	}
+	restoreExpr := &RestoreExpr{RestorePos: pos}
+	var err error
+	restoreExpr.Elements, restoreExpr.OnCluster, err = p.parseBackupElements()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordFrom); err != nil {
+		return nil, err
+	}
+	restoreExpr.Source, err = p.parseTableFunctionCall(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	restoreExpr.StatementEnd = restoreExpr.Source.End()
+	restoreExpr.Settings, restoreExpr.Mode, err = p.parseBackupOptions(&restoreExpr.StatementEnd)
+	if err != nil {
+		return nil, err
+	}
+	return restoreExpr, nil
+}
+
+func (p *Parser) parseBackupElements() ([]*BackupElement, *OnClusterExpr, error) {
+	var elements []*BackupElement
+	for {
+		element, err := p.parseBackupElement(p.Pos())
+		if err != nil {
+			return nil, nil, err
+		}
+		elements = append(elements, element)
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	onCluster, err := p.tryParseOnCluster(p.Pos())
+	if err != nil {
+		return nil, nil, err
+	}
+	return elements, onCluster, nil
+}
+
+// parseBackupOptions parses the trailing SETTINGS and SYNC|ASYNC, and moves the end of the statement.
+func (p *Parser) parseBackupOptions(end *Pos) (*SettingsExprList, string, error) {
+	settings, err := p.tryParseSettingsExprList(p.Pos())
+	if err != nil {
+		return nil, "", err
+	}
+	if settings != nil {
+		*end = settings.End()
+	}
+	var mode string
+	switch {
+	case p.matchKeyword(KeywordSync), p.matchKeyword(KeywordAsync):
+		mode = strings.ToUpper(p.last().String)
+		*end = p.last().End
+		_ = p.lexer.consumeToken()
+	}
+	return settings, mode, nil
+}
+
+// matchBackupElementAfterComma reports whether the comma is followed by the next element
+// rather than the next item of the current element list.
+func (p *Parser) matchBackupElementAfterComma() bool {
+	if !p.matchTokenKind(",") {
+		return false
+	}
+	nextToken, err := p.lexer.peekToken()
+	if err != nil || nextToken == nil || nextToken.Kind != TokenKeyword {
+		return false
+	}
+	switch strings.ToUpper(nextToken.String) {
+	case KeywordTable, KeywordTemporary, KeywordDictionary, KeywordView, KeywordDatabase, KeywordAll:
+		return true
+	}
+	return false
+}
+
+// Syntax: TABLE table [AS name] [PARTITION[S] partition [, ...]]
+// | TEMPORARY TABLE table [AS name] | DICTIONARY dict [AS name] | VIEW view [AS name]
+// | DATABASE db [AS name] [EXCEPT TABLES table [, ...]]
+// | ALL [EXCEPT {TABLES|DATABASES} name [, ...]]
+func (p *Parser) parseBackupElement(pos Pos) (*BackupElement, error) {
+	element := &BackupElement{ElementPos: pos}
+	switch {
+	case p.matchKeyword(KeywordTable), p.matchKeyword(KeywordDictionary), p.matchKeyword(KeywordView):
+		element.Kind = strings.ToUpper(p.last().String)
+		_ = p.lexer.consumeToken()
+	case p.tryConsumeKeyword(KeywordTemporary) != nil:
+		if err := p.consumeKeyword(KeywordTable); err != nil {
+			return nil, err
+		}
+		element.Kind = "TEMPORARY TABLE"
+	case p.tryConsumeKeyword(KeywordDatabase) != nil:
+		element.Kind = KeywordDatabase
+	case p.matchKeyword(KeywordAll):
+		element.Kind = KeywordAll
+		element.ElementEnd = p.last().End
+		_ = p.lexer.consumeToken()
+	default:
+		return nil, fmt.Errorf("expected TABLE|DICTIONARY|VIEW|DATABASE|TEMPORARY|ALL, but got %q", p.lastTokenKind())
+	}
+
+	switch element.Kind {
+	case KeywordAll:
+	case KeywordDatabase:
+		database, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+		element.Database = database
+		element.ElementEnd = database.End()
+	default:
+		table, err := p.parseTableIdentifier(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		element.Table = table
+		element.ElementEnd = table.End()
+	}
+
+	if element.Kind != KeywordAll && p.tryConsumeKeyword(KeywordAs) != nil {
+		alias, err := p.parseTableIdentifier(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		element.Alias = alias
+		element.ElementEnd = alias.End()
+	}
+
+	if element.Kind == KeywordTable && (p.matchKeyword(KeywordPartition) ||
+		(p.matchTokenKind(TokenIdent) && strings.EqualFold(p.last().String, "PARTITIONS"))) {
+		_ = p.lexer.consumeToken()
+		for {
+			partition, err := p.parseExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			element.Partitions = append(element.Partitions, partition)
+			element.ElementEnd = partition.End()
+			if p.matchBackupElementAfterComma() || p.tryConsumeTokenKind(",") == nil {
+				break
+			}
+		}
+	}
+
+	if (element.Kind == KeywordDatabase || element.Kind == KeywordAll) && p.tryConsumeKeyword(KeywordExcept) != nil {
+		switch {
+		case p.matchKeyword(KeywordTables):
+			element.ExceptKind = KeywordTables
+		case element.Kind == KeywordAll && p.matchKeyword(KeywordDatabases):
+			element.ExceptKind = KeywordDatabases
+		default:
+			return nil, fmt.Errorf("expected TABLES or DATABASES, but got %q", p.lastTokenKind())
+		}
+		_ = p.lexer.consumeToken()
+		for {
+			except, err := p.parseTableIdentifier(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			element.Except = append(element.Except, except)
+			element.ElementEnd = except.End()
+			if p.matchBackupElementAfterComma() || p.tryConsumeTokenKind(",") == nil {
+				break
+			}
+		}
+	}
+	return element, nil
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -94,7 +95,7 @@ func (p *Parser) parseNotExpr(pos Pos) (Expr, error) {
 }
 
 func (p *Parser) parseIsOrNotNull(pos Pos) (Expr, error) {
-	expr, err := p.parseCompareExpr(p.Pos())
+	expr, err := p.parseBetweenExpr(p.Pos())
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +104,20 @@ func (p *Parser) parseIsOrNotNull(pos Pos) (Expr, error) {
 	}
 
 	isNotNull := p.tryConsumeKeyword(KeywordNot) != nil
+	if p.tryConsumeKeyword(KeywordDistinct) != nil {
+		if err := p.consumeKeyword(KeywordFrom); err != nil {
+			return nil, err
+		}
+		rightExpr, err := p.parseBetweenExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		return &IsDistinctFromExpr{
+			LeftExpr:  expr,
+			HasNot:    isNotNull,
+			RightExpr: rightExpr,
+		}, nil
+	}
 	if err := p.consumeKeyword(KeywordNull); err != nil {
 		return nil, err
 	}
@@ -119,6 +134,43 @@ func (p *Parser) parseIsOrNotNull(pos Pos) (Expr, error) {
 	}, nil
 }
 
+// syntax: expr [NOT] BETWEEN lower AND upper
+func (p *Parser) parseBetweenExpr(pos Pos) (Expr, error) {
+	expr, err := p.parseCompareExpr(pos)
+	if err != nil {
+		return nil, err
+	}
+	hasNot := false
+	if p.matchKeyword(KeywordNot) {
+		// NOT before other operators is consumed by the comparison expression
+		_ = p.lexer.consumeToken()
+		hasNot = true
+	}
+	if p.tryConsumeKeyword(KeywordBetween) == nil {
+		if hasNot {
+			return nil, fmt.Errorf("expected BETWEEN after NOT, got %s", p.lastTokenKind())
+		}
+		return expr, nil
+	}
+	lower, err := p.parseCompareExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordAnd); err != nil {
+		return nil, err
+	}
+	upper, err := p.parseCompareExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &BetweenExpr{
+		Expr:   expr,
+		HasNot: hasNot,
+		Lower:  lower,
+		Upper:  upper,
+	}, nil
+}
+
 func (p *Parser) parseCompareExpr(pos Pos) (Expr, error) {
 	hasNot, hasGlobal := false, false
 	expr, err := p.parseAddSubExpr(pos)
@@ -126,15 +178,6 @@ func (p *Parser) parseCompareExpr(pos Pos) (Expr, error) {
 		return nil, err
 	}
 	switch {
-	case p.matchTokenKind("["):
-		params, err := p.parseArrayParams(pos)
-		if err != nil {
-			return nil, err
-		}
-		return &ObjectParams{
-			Object: expr,
-			Params: params,
-		}, nil
 	case p.matchTokenKind(opTypeEQ):
 	case p.matchTokenKind(opTypeLT):
 	case p.matchTokenKind(opTypeLE):
@@ -145,19 +188,33 @@ func (p *Parser) parseCompareExpr(pos Pos) (Expr, error) {
 	case p.matchTokenKind("<>"):
 	case p.matchTokenKind(opTypeQuery):
 	case p.matchKeyword(KeywordIn):
+		// IN PARTITION belongs to the enclosing mutation
+		if p.matchInPartition() {
+			return expr, nil
+		}
 	case p.matchKeyword(KeywordLike):
 	case p.matchKeyword(KeywordIlike):
+	case p.matchKeyword(KeywordRegexp):
 	case p.matchKeyword(KeywordGlobal):
 		_ = p.lexer.consumeToken()
 		hasGlobal = true
+		hasNot = p.tryConsumeKeyword(KeywordNot) != nil
+		if !p.matchKeyword(KeywordIn) {
+			return nil, fmt.Errorf("expected IN after GLOBAL, got %s", p.lastTokenKind())
+		}
 	case p.matchKeyword(KeywordNot):
+		// NOT BETWEEN is handled by the caller
+		if nextToken, _ := p.lexer.peekToken(); nextToken != nil && nextToken.Kind == TokenKeyword &&
+			strings.EqualFold(nextToken.String, KeywordBetween) {
+			return expr, nil
+		}
 		_ = p.lexer.consumeToken()
 		switch {
 		case p.matchKeyword(KeywordIn):
 		case p.matchKeyword(KeywordLike):
 		case p.matchKeyword(KeywordIlike):
 		default:
-			return nil, fmt.Errorf("expected IN, LIKE or ILIKE after NOT, got %s", p.lastTokenKind())
+			return nil, fmt.Errorf("expected IN, LIKE, ILIKE or BETWEEN after NOT, got %s", p.lastTokenKind())
 		}
 		hasNot = true
 	default:
@@ -235,10 +292,11 @@ func (p *Parser) parseMulDivModExpr(pos Pos) (Expr, error) {
 		switch {
 		case p.matchTokenKind(opTypeQuery):
 			return p.parseTernaryExpr(expr)
+		case p.matchTokenKind(opTypeArrow):
+			return p.parseLambdaExpr(expr)
 		case p.matchTokenKind(opTypeMul),
 			p.matchTokenKind(opTypeDiv),
 			p.matchTokenKind(opTypeMod),
-			p.matchTokenKind(opTypeArrow),
 			p.matchTokenKind(opTypeCast):
 			op := p.lastTokenKind()
 			_ = p.lexer.consumeToken()
@@ -257,6 +315,41 @@ func (p *Parser) parseMulDivModExpr(pos Pos) (Expr, error) {
 	}
 }
 
+// parseLambdaExpr parses the lambda body after the parameters `x` or `(x, y)`,
+// which were parsed as an identifier or a parenthesized expression list.
+func (p *Parser) parseLambdaExpr(paramsExpr Expr) (*LambdaExpr, error) {
+	var params []*Ident
+	switch expr := paramsExpr.(type) {
+	case *Ident:
+		params = append(params, expr)
+	case *ParamExprList:
+		if expr.ColumnArgList != nil {
+			return nil, errors.New("lambda parameters must be identifiers")
+		}
+		for _, item := range expr.Items.Items {
+			ident, ok := item.(*Ident)
+			if !ok {
+				return nil, fmt.Errorf("lambda parameter must be an identifier, but got %q", item.String(0))
+			}
+			params = append(params, ident)
+		}
+	default:
+		return nil, fmt.Errorf("lambda parameters must be identifiers, but got %q", paramsExpr.String(0))
+	}
+	if _, err := p.consumeTokenKind(opTypeArrow); err != nil {
+		return nil, err
+	}
+	body, err := p.parseExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &LambdaExpr{
+		ParamsPos: paramsExpr.Pos(),
+		Params:    params,
+		Body:      body,
+	}, nil
+}
+
 func (p *Parser) parseColumnExtractExpr(pos Pos) (*ExtractExpr, error) {
 	if err := p.consumeKeyword(KeywordExtract); err != nil {
 		return nil, err
@@ -326,7 +419,87 @@ func (p *Parser) parseUnaryExpr(pos Pos) (Expr, error) {
 
 }
 
-func (p *Parser) parseColumnExpr(pos Pos) (Expr, error) { //nolint:funlen
+func (p *Parser) parseColumnExpr(pos Pos) (Expr, error) {
+	expr, err := p.parsePrimaryColumnExpr(pos)
+	if err != nil {
+		return nil, err
+	}
+	return p.parsePostfixExpr(expr)
+}
+
+// parsePostfixExpr parses the subscripts, tuple elements and subcolumns after the expression,
+// like arr[1], m['key'], t.1, json.a.b.c and json.a.:Int64.
+func (p *Parser) parsePostfixExpr(expr Expr) (Expr, error) {
+	for {
+		switch {
+		case p.matchTokenKind("["):
+			_ = p.lexer.consumeToken()
+			index, err := p.parseExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			rightBracket, err := p.consumeTokenKind("]")
+			if err != nil {
+				return nil, err
+			}
+			expr = &IndexExpr{
+				Object:   expr,
+				Index:    index,
+				IndexEnd: rightBracket.End,
+			}
+		case p.matchTokenKind("."):
+			_ = p.lexer.consumeToken()
+			switch {
+			case p.matchTokenKind(TokenInt):
+				index, err := p.parseNumber(p.Pos())
+				if err != nil {
+					return nil, err
+				}
+				expr = &TupleElementExpr{
+					Tuple: expr,
+					Index: index,
+				}
+			case p.matchTokenKind(TokenIdent):
+				ident, err := p.parseIdent()
+				if err != nil {
+					return nil, err
+				}
+				// extend the path unless the type hint was specified
+				if path, ok := expr.(*SubcolumnPath); ok && path.Type == nil {
+					path.Path = append(path.Path, ident)
+					path.PathEnd = ident.End()
+				} else {
+					expr = &SubcolumnPath{
+						Object:  expr,
+						Path:    []*Ident{ident},
+						PathEnd: ident.End(),
+					}
+				}
+			case p.tryConsumeTokenKind(":") != nil:
+				columnType, err := p.parseColumnType(p.Pos())
+				if err != nil {
+					return nil, err
+				}
+				if path, ok := expr.(*SubcolumnPath); ok && path.Type == nil {
+					path.Type = columnType
+					path.PathEnd = columnType.End()
+				} else {
+					expr = &SubcolumnPath{
+						Object:  expr,
+						Type:    columnType,
+						PathEnd: columnType.End(),
+					}
+				}
+			default:
+				return nil, fmt.Errorf("expected <int>, <ident> or : after '.', but got %q", p.lastTokenKind())
+			}
+		default:
+			return expr, nil
+		}
+	}
+}
+
+func (p *Parser) parsePrimaryColumnExpr(pos Pos) (Expr, error) { //nolint:funlen
 	switch {
 	case p.matchKeyword(KeywordInterval):
 		return p.parseColumnExprInterval(pos)
@@ -345,8 +518,22 @@ func (p *Parser) parseColumnExpr(pos Pos) (Expr, error) { //nolint:funlen
 		return p.parseColumnCaseExpr(pos)
 	case p.matchKeyword(KeywordExtract):
 		return p.parseColumnExtractExpr(pos)
+	case p.matchColumnsMatcher():
+		matcher, err := p.parseColumnsMatcher(pos)
+		if err != nil {
+			return nil, err
+		}
+		return p.tryParseColumnTransformers(matcher)
 	case p.matchTokenKind(TokenIdent):
-		return p.parseIdentOrFunction(pos)
+		expr, err := p.parseIdentOrFunction(pos)
+		if err != nil {
+			return nil, err
+		}
+		// t.* can be followed by the column transformers
+		if nested, ok := expr.(*NestedIdentifier); ok && nested.DotIdent != nil && nested.DotIdent.Name == "*" {
+			return p.tryParseColumnTransformers(expr)
+		}
+		return expr, nil
 	case p.matchTokenKind(TokenString): // string literal
 		return p.parseString(pos)
 	case p.matchTokenKind(TokenInt),
@@ -355,15 +542,24 @@ func (p *Parser) parseColumnExpr(pos Pos) (Expr, error) { //nolint:funlen
 	case p.matchTokenKind("("):
 		if peek, _ := p.lexer.peekToken(); peek != nil {
 			if peek.Kind == TokenKeyword && strings.EqualFold(peek.String, KeywordSelect) {
-				return p.parseSelectQuery(pos)
+				return p.parseSubSelectQuery(pos)
 			}
 		}
 		return p.parseFunctionParams(pos)
 	case p.matchTokenKind("*"):
-		return p.parseColumnStar(pos)
+		star, err := p.parseColumnStar(pos)
+		if err != nil {
+			return nil, err
+		}
+		return p.tryParseColumnTransformers(star)
 	case p.matchTokenKind("["):
 		return p.parseArrayParams(pos)
-
+	case p.matchTokenKind("{"):
+		// {name:Type} is the query parameter, otherwise it's the map literal
+		if peek, _ := p.lexer.peekToken(); peek != nil && (peek.Kind == TokenIdent || peek.Kind == TokenKeyword) {
+			return p.parseQueryParam(pos)
+		}
+		return p.parseMapLiteral(pos)
 	default:
 		return nil, fmt.Errorf("unexpected token kind: %s", p.lastTokenKind())
 	}
@@ -439,6 +635,13 @@ func (p *Parser) parseColumnExprListWithTerm(term TokenKind, pos Pos) (*ColumnEx
 		ListEnd: pos,
 	}
 	columnExprList.HasDistinct = p.tryConsumeKeyword(KeywordDistinct) != nil
+	if columnExprList.HasDistinct && p.matchKeyword(KeywordOn) {
+		distinctOn, err := p.parseDistinctOn(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		columnExprList.DistinctOn = distinctOn
+	}
 	columnList := make([]Expr, 0)
 	for !p.lexer.isEOF() || p.last() != nil {
 		if term != "" && p.matchTokenKind(term) {
@@ -463,6 +666,28 @@ func (p *Parser) parseColumnExprListWithTerm(term TokenKind, pos Pos) (*ColumnEx
 	return columnExprList, nil
 }
 
+// Syntax: ON (columnExpr, ...)
+func (p *Parser) parseDistinctOn(_ Pos) (*ColumnExprList, error) {
+	if err := p.consumeKeyword(KeywordOn); err != nil {
+		return nil, err
+	}
+	lParen, err := p.consumeTokenKind("(")
+	if err != nil {
+		return nil, err
+	}
+	columns, err := p.parseColumnExprListWithRoundBracket(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	rParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	columns.ListPos = lParen.Pos
+	columns.ListEnd = rParen.End
+	return columns, nil
+}
+
 // Syntax: INTERVAL expr interval
 func (p *Parser) parseColumnExprInterval(pos Pos) (Expr, error) {
 	if err := p.consumeKeyword(KeywordInterval); err != nil {
@@ -490,26 +715,6 @@ func (p *Parser) parseColumnExprInterval(pos Pos) (Expr, error) {
 	}, nil
 }
 
-func (p *Parser) parseFunctionExpr(_ Pos) (Expr, error) {
-	if _, err := p.consumeTokenKind(TokenIdent); err != nil {
-		return nil, err
-	}
-	// parse function name
-	name, err := p.parseIdent()
-	if err != nil {
-		return nil, err
-	}
-	// parse function params
-	params, err := p.parseFunctionParams(p.Pos())
-	if err != nil {
-		return nil, err
-	}
-	return &FunctionExpr{
-		Name:   name,
-		Params: params,
-	}, nil
-}
-
 func (p *Parser) parseColumnArgList(pos Pos) (*ColumnArgList, error) {
 	if _, err := p.consumeTokenKind("("); err != nil {
 		return nil, err
@@ -570,6 +775,42 @@ func (p *Parser) parseFunctionParams(pos Pos) (*ParamExprList, error) {
 	return paramExprList, nil
 }
 
+func (p *Parser) parseMapLiteral(pos Pos) (*MapLiteral, error) {
+	if _, err := p.consumeTokenKind("{"); err != nil {
+		return nil, err
+	}
+	keyValues := make([]KeyValue, 0)
+	for !p.lexer.isEOF() && !p.matchTokenKind("}") {
+		key, err := p.parseExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.consumeTokenKind(":"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		keyValues = append(keyValues, KeyValue{
+			Key:   key,
+			Value: value,
+		})
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	rightBrace, err := p.consumeTokenKind("}")
+	if err != nil {
+		return nil, err
+	}
+	return &MapLiteral{
+		LBracePos: pos,
+		RBracePos: rightBrace.End,
+		KeyValues: keyValues,
+	}, nil
+}
+
 func (p *Parser) parseArrayParams(pos Pos) (*ArrayParamList, error) {
 	if _, err := p.consumeTokenKind("["); err != nil {
 		return nil, err
@@ -859,6 +1100,190 @@ func (p *Parser) parseColumnStar(pos Pos) (*Ident, error) {
 	}, nil
 }
 
+func (p *Parser) matchColumnsMatcher() bool {
+	if !p.matchKeyword(KeywordColumns) {
+		return false
+	}
+	nextToken, err := p.lexer.peekToken()
+	return err == nil && nextToken != nil && nextToken.Kind == "("
+}
+
+// Syntax: COLUMNS('regexp') | COLUMNS(columnExpr, ...)
+func (p *Parser) parseColumnsMatcher(pos Pos) (*ColumnsMatcher, error) {
+	if err := p.consumeKeyword(KeywordColumns); err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	matcher := &ColumnsMatcher{ColumnsPos: pos}
+	if p.matchTokenKind(TokenString) {
+		pattern, err := p.parseString(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		matcher.Pattern = pattern
+	} else {
+		columns, err := p.parseColumnExprListWithRoundBracket(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		matcher.Columns = columns
+	}
+	rParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	matcher.RParenPos = rParen.Pos
+	return matcher, nil
+}
+
+// tryParseColumnTransformers parses the chained EXCEPT, REPLACE and APPLY transformers
+// after the column matcher, the matcher is returned as is if there's no transformer.
+func (p *Parser) tryParseColumnTransformers(matcher Expr) (Expr, error) {
+	var transformers []Expr
+	for {
+		var transformer Expr
+		var err error
+		switch {
+		case p.matchKeyword(KeywordExcept):
+			// EXCEPT SELECT is the set operation rather than the transformer
+			if nextToken, _ := p.lexer.peekToken(); nextToken != nil &&
+				nextToken.Kind == TokenKeyword && strings.EqualFold(nextToken.String, KeywordSelect) {
+				break
+			}
+			transformer, err = p.parseColumnExceptTransformer(p.Pos())
+		case p.matchKeyword(KeywordReplace):
+			transformer, err = p.parseColumnReplaceTransformer(p.Pos())
+		case p.matchKeyword(KeywordApply):
+			transformer, err = p.parseColumnApplyTransformer(p.Pos())
+		}
+		if err != nil {
+			return nil, err
+		}
+		if transformer == nil {
+			break
+		}
+		transformers = append(transformers, transformer)
+	}
+	if len(transformers) == 0 {
+		return matcher, nil
+	}
+	return &ColumnTransformerExpr{
+		Matcher:      matcher,
+		Transformers: transformers,
+	}, nil
+}
+
+// Syntax: EXCEPT [STRICT] (ident, ...) | EXCEPT ident | EXCEPT('regexp')
+func (p *Parser) parseColumnExceptTransformer(pos Pos) (*ColumnExceptTransformer, error) {
+	if err := p.consumeKeyword(KeywordExcept); err != nil {
+		return nil, err
+	}
+	transformer := &ColumnExceptTransformer{
+		ExceptPos: pos,
+		Strict:    p.tryConsumeKeyword(KeywordStrict) != nil,
+	}
+	if p.tryConsumeTokenKind("(") == nil {
+		ident, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+		transformer.Columns = []Expr{ident}
+		transformer.TransformerEnd = ident.End()
+		return transformer, nil
+	}
+	transformer.HasParen = true
+	if p.matchTokenKind(TokenString) {
+		pattern, err := p.parseString(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		transformer.Columns = []Expr{pattern}
+	} else {
+		for {
+			ident, err := p.parseIdent()
+			if err != nil {
+				return nil, err
+			}
+			transformer.Columns = append(transformer.Columns, ident)
+			if p.tryConsumeTokenKind(",") == nil {
+				break
+			}
+		}
+	}
+	rParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	transformer.TransformerEnd = rParen.End
+	return transformer, nil
+}
+
+// Syntax: REPLACE [STRICT] (columnExpr AS ident, ...) | REPLACE columnExpr AS ident
+func (p *Parser) parseColumnReplaceTransformer(pos Pos) (*ColumnReplaceTransformer, error) {
+	if err := p.consumeKeyword(KeywordReplace); err != nil {
+		return nil, err
+	}
+	transformer := &ColumnReplaceTransformer{
+		ReplacePos: pos,
+		Strict:     p.tryConsumeKeyword(KeywordStrict) != nil,
+	}
+	transformer.HasParen = p.tryConsumeTokenKind("(") != nil
+	for {
+		expr, err := p.parseExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		alias, ok := expr.(*AliasExpr)
+		if !ok {
+			return nil, fmt.Errorf("expected <expr> AS <ident> in REPLACE, but got %q", expr.String(0))
+		}
+		transformer.Replaces = append(transformer.Replaces, alias)
+		transformer.TransformerEnd = alias.End()
+		if !transformer.HasParen || p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	if transformer.HasParen {
+		rParen, err := p.consumeTokenKind(")")
+		if err != nil {
+			return nil, err
+		}
+		transformer.TransformerEnd = rParen.End
+	}
+	return transformer, nil
+}
+
+// Syntax: APPLY(func) | APPLY func
+func (p *Parser) parseColumnApplyTransformer(pos Pos) (*ColumnApplyTransformer, error) {
+	if err := p.consumeKeyword(KeywordApply); err != nil {
+		return nil, err
+	}
+	transformer := &ColumnApplyTransformer{ApplyPos: pos}
+	if p.tryConsumeTokenKind("(") == nil {
+		fn, err := p.parseIdentOrFunction(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		transformer.Func = fn
+		transformer.TransformerEnd = fn.End()
+		return transformer, nil
+	}
+	transformer.HasParen = true
+	fn, err := p.parseExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	transformer.Func = fn
+	rParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	transformer.TransformerEnd = rParen.End
+	return transformer, nil
+}
+
 func (p *Parser) tryParseCompressionLevel(pos Pos) (*NumberLiteral, error) {
 	if p.tryConsumeTokenKind("(") == nil {
 		return nil, nil // nolint
@@ -7,7 +7,8 @@ import (
 )
 
 type Parser struct {
-	lexer *Lexer
+	lexer       *Lexer
+	queryParams []*QueryParam
 }
 
 func NewParser(buffer string) *Parser {
@@ -16,6 +17,12 @@ func NewParser(buffer string) *Parser {
 	}
 }
 
+// QueryParams returns the query parameters like {name:Type} in the parsed statements,
+// in the order they appear.
+func (p *Parser) QueryParams() []*QueryParam {
+	return p.queryParams
+}
+
 func (p *Parser) lastTokenKind() TokenKind {
 	if p.last() == nil {
 		return TokenEOF
@@ -224,10 +231,18 @@ func (p *Parser) parseString(pos Pos) (*StringLiteral, error) {
 	if err != nil {
 		return nil, err
 	}
+	value := lastToken.String
+	if lastToken.QuoteType != Heredoc {
+		value, err = UnescapeString(lastToken.String)
+		if err != nil {
+			return nil, err
+		}
+	}
 	str := &StringLiteral{
 		LiteralPos: pos,
 		LiteralEnd: lastToken.End,
 		Literal:    lastToken.String,
+		Value:      value,
 	}
 	return str, nil
 }
@@ -246,6 +261,36 @@ func (p *Parser) parseLiteral(pos Pos) (Literal, error) {
 	}
 }
 
+// {name:Type}
+func (p *Parser) parseQueryParam(pos Pos) (*QueryParam, error) {
+	if _, err := p.consumeTokenKind("{"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind(":"); err != nil {
+		return nil, err
+	}
+	columnType, err := p.parseColumnType(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	rightBrace, err := p.consumeTokenKind("}")
+	if err != nil {
+		return nil, err
+	}
+	queryParam := &QueryParam{
+		LBracePos: pos,
+		RBracePos: rightBrace.End,
+		Name:      name,
+		Type:      columnType,
+	}
+	p.queryParams = append(p.queryParams, queryParam)
+	return queryParam, nil
+}
+
 func (p *Parser) ParseNestedIdentifier(pos Pos) (*NestedIdentifier, error) {
 	ident, err := p.parseIdent()
 	if err != nil {
@@ -266,6 +311,55 @@ func (p *Parser) ParseNestedIdentifier(pos Pos) (*NestedIdentifier, error) {
 	}, nil
 }
 
+// Syntax: INTO OUTFILE STRING_LITERAL (AND STDOUT)? (APPEND | TRUNCATE)? (COMPRESSION STRING_LITERAL (LEVEL INT)?)?
+func (p *Parser) tryParseIntoOutfileExpr(pos Pos) (*IntoOutfileExpr, error) {
+	if p.tryConsumeKeyword(KeywordInto) == nil {
+		return nil, nil // nolint
+	}
+	if err := p.consumeKeyword(KeywordOutfile); err != nil {
+		return nil, err
+	}
+	filename, err := p.parseString(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	intoOutfile := &IntoOutfileExpr{
+		IntoPos:  pos,
+		IntoEnd:  filename.End(),
+		Filename: filename,
+	}
+	if p.tryConsumeKeyword(KeywordAnd) != nil {
+		if !p.matchTokenKind(TokenIdent) || !strings.EqualFold(p.last().String, "STDOUT") {
+			return nil, fmt.Errorf("expected STDOUT, but got %q", p.lastTokenKind())
+		}
+		intoOutfile.AndStdout = true
+		intoOutfile.IntoEnd = p.last().End
+		_ = p.lexer.consumeToken()
+	}
+	if p.matchKeyword(KeywordAppend) || p.matchKeyword(KeywordTruncate) {
+		intoOutfile.Mode = strings.ToUpper(p.last().String)
+		intoOutfile.IntoEnd = p.last().End
+		_ = p.lexer.consumeToken()
+	}
+	if p.matchTokenKind(TokenIdent) && strings.EqualFold(p.last().String, "COMPRESSION") {
+		_ = p.lexer.consumeToken()
+		intoOutfile.Compression, err = p.parseString(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		intoOutfile.IntoEnd = intoOutfile.Compression.End()
+		if p.matchTokenKind(TokenIdent) && strings.EqualFold(p.last().String, "LEVEL") {
+			_ = p.lexer.consumeToken()
+			intoOutfile.Level, err = p.parseNumber(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			intoOutfile.IntoEnd = intoOutfile.Level.End()
+		}
+	}
+	return intoOutfile, nil
+}
+
 func (p *Parser) tryParseFormatExpr(pos Pos) (*FormatExpr, error) {
 	if !p.matchKeyword(KeywordFormat) {
 		return nil, nil // nolint
@@ -314,7 +408,11 @@ func (p *Parser) wrapError(err error) error {
 			for j := 0; j < column; j++ {
 				buf.WriteByte(' ')
 			}
-			buf.WriteString(strings.Repeat("^", len(p.lexer.lastToken.String)))
+			width := 1
+			if p.last() != nil {
+				width = len(p.last().String)
+			}
+			buf.WriteString(strings.Repeat("^", width))
 			buf.WriteByte('\n')
 		}
 	}
@@ -0,0 +1,380 @@
+package parser
+
+import "fmt"
+
+// (ATTACH | CREATE) (OR REPLACE)? DICTIONARY (IF NOT EXISTS)? tableIdentifier uuidClause? clusterClause? dictionarySchemaClause dictionaryEngineClause
+func (p *Parser) parseCreateDictionary(pos Pos) (*CreateDictionary, error) {
+	createDictionary := &CreateDictionary{CreatePos: pos}
+	if p.tryConsumeKeyword(KeywordOr) != nil {
+		if err := p.consumeKeyword(KeywordReplace); err != nil {
+			return nil, err
+		}
+		createDictionary.OrReplace = true
+	}
+	if err := p.consumeKeyword(KeywordDictionary); err != nil {
+		return nil, err
+	}
+
+	// parse IF NOT EXISTS clause if exists
+	var err error
+	createDictionary.IfNotExists, err = p.tryParseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+
+	createDictionary.Name, err = p.parseTableIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	createDictionary.UUID, err = p.tryParseUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	createDictionary.OnCluster, err = p.tryParseOnCluster(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	createDictionary.Schema, err = p.parseDictionarySchemaExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	createDictionary.StatementEnd = createDictionary.Schema.End()
+
+	// the dictionary clauses can be specified in any order
+	for {
+		var clause Expr
+		switch {
+		case p.matchKeyword(KeywordPrimary):
+			createDictionary.PrimaryKey, err = p.parseDictionaryPrimaryKey(p.Pos())
+			clause = createDictionary.PrimaryKey
+		case p.matchKeyword(KeywordSource):
+			createDictionary.Source, err = p.parseDictionarySourceExpr(p.Pos())
+			clause = createDictionary.Source
+		case p.matchKeyword(KeywordLayout):
+			createDictionary.Layout, err = p.parseDictionaryLayoutExpr(p.Pos())
+			clause = createDictionary.Layout
+		case p.matchKeyword(KeywordLifetime):
+			createDictionary.Lifetime, err = p.parseDictionaryLifetimeExpr(p.Pos())
+			clause = createDictionary.Lifetime
+		case p.matchKeyword(KeywordRange):
+			createDictionary.Range, err = p.parseDictionaryRangeExpr(p.Pos())
+			clause = createDictionary.Range
+		case p.matchKeyword(KeywordSettings):
+			createDictionary.Settings, err = p.parseDictionarySettings(p.Pos())
+			clause = createDictionary.Settings
+		case p.matchKeyword(KeywordComment):
+			_ = p.lexer.consumeToken()
+			createDictionary.Comment, err = p.parseString(p.Pos())
+			clause = createDictionary.Comment
+		default:
+			return createDictionary, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		createDictionary.StatementEnd = clause.End()
+	}
+}
+
+func (p *Parser) parseDictionarySchemaExpr(pos Pos) (*DictionarySchemaExpr, error) {
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	attributes := make([]*DictionaryAttribute, 0)
+	for !p.matchTokenKind(")") {
+		attribute, err := p.parseDictionaryAttribute(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, attribute)
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	rightParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	return &DictionarySchemaExpr{
+		SchemaPos:  pos,
+		SchemaEnd:  rightParen.End,
+		Attributes: attributes,
+	}, nil
+}
+
+func (p *Parser) parseDictionaryAttribute(pos Pos) (*DictionaryAttribute, error) {
+	name, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+	columnType, err := p.parseColumnType(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	attribute := &DictionaryAttribute{
+		NamePos:      pos,
+		AttributeEnd: columnType.End(),
+		Name:         name,
+		Type:         columnType,
+	}
+	for {
+		lastToken := p.last()
+		switch {
+		case p.tryConsumeKeyword(KeywordDefault) != nil:
+			attribute.Default, err = p.parseExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			attribute.AttributeEnd = attribute.Default.End()
+		case p.tryConsumeKeyword(KeywordExpression) != nil:
+			attribute.Expression, err = p.parseExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			attribute.AttributeEnd = attribute.Expression.End()
+		case p.tryConsumeKeyword(KeywordHierarchical) != nil:
+			attribute.Hierarchical = true
+			attribute.AttributeEnd = lastToken.End
+		case p.tryConsumeKeyword(KeywordInjective) != nil:
+			attribute.Injective = true
+			attribute.AttributeEnd = lastToken.End
+		case p.tryConsumeKeyword(KeywordIs_object_id) != nil:
+			attribute.IsObjectID = true
+			attribute.AttributeEnd = lastToken.End
+		default:
+			return attribute, nil
+		}
+	}
+}
+
+func (p *Parser) parseDictionaryPrimaryKey(pos Pos) (*PrimaryKeyExpr, error) {
+	if err := p.consumeKeyword(KeywordPrimary); err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordKey); err != nil {
+		return nil, err
+	}
+	// dictionary keys are a comma separated list without brackets
+	keys, err := p.parseColumnExprList(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &PrimaryKeyExpr{
+		PrimaryPos: pos,
+		Expr:       keys,
+	}, nil
+}
+
+// parseDictionaryArgs parses the arguments of SOURCE and LAYOUT clauses,
+// which are a list of `name value` or nested `name(name value ...)` pairs separated by spaces.
+func (p *Parser) parseDictionaryArgs(_ Pos) ([]*DictionaryArgExpr, error) {
+	args := make([]*DictionaryArgExpr, 0)
+	for !p.matchTokenKind(")") {
+		name, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+		var value Expr
+		switch {
+		case p.matchTokenKind(TokenInt), p.matchTokenKind(TokenFloat), p.matchTokenKind(TokenString):
+			value, err = p.parseLiteral(p.Pos())
+		case p.matchTokenKind(TokenIdent):
+			value, err = p.parseIdent()
+		case p.matchTokenKind("("):
+			value, err = p.parseDictionaryArgList(p.Pos())
+		default:
+			return nil, fmt.Errorf("expected <literal> or <ident> as the value of %q, but got %q", name.Name, p.lastTokenKind())
+		}
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, &DictionaryArgExpr{
+			Name:  name,
+			Value: value,
+		})
+		// the arguments could be also separated by comma
+		_ = p.tryConsumeTokenKind(",")
+	}
+	return args, nil
+}
+
+func (p *Parser) parseDictionaryArgList(pos Pos) (*DictionaryArgListExpr, error) {
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	args, err := p.parseDictionaryArgs(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	rightParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	return &DictionaryArgListExpr{
+		LParenPos: pos,
+		RParenPos: rightParen.End,
+		Args:      args,
+	}, nil
+}
+
+func (p *Parser) parseDictionarySourceExpr(pos Pos) (*DictionarySourceExpr, error) {
+	if err := p.consumeKeyword(KeywordSource); err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	source, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	args, err := p.parseDictionaryArgs(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind(")"); err != nil {
+		return nil, err
+	}
+	rightParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	return &DictionarySourceExpr{
+		SourcePos: pos,
+		RParenPos: rightParen.End,
+		Source:    source,
+		Args:      args,
+	}, nil
+}
+
+func (p *Parser) parseDictionaryLayoutExpr(pos Pos) (*DictionaryLayoutExpr, error) {
+	if err := p.consumeKeyword(KeywordLayout); err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	layout, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	args, err := p.parseDictionaryArgs(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind(")"); err != nil {
+		return nil, err
+	}
+	rightParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	return &DictionaryLayoutExpr{
+		LayoutPos: pos,
+		RParenPos: rightParen.End,
+		Layout:    layout,
+		Args:      args,
+	}, nil
+}
+
+// LIFETIME(MIN min_val MAX max_val) | LIFETIME(max_val)
+func (p *Parser) parseDictionaryLifetimeExpr(pos Pos) (*DictionaryLifetimeExpr, error) {
+	if err := p.consumeKeyword(KeywordLifetime); err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	lifetime := &DictionaryLifetimeExpr{LifetimePos: pos}
+	var err error
+	if p.matchTokenKind(TokenInt) {
+		lifetime.Value, err = p.parseNumber(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for lifetime.Min == nil || lifetime.Max == nil {
+			switch {
+			case p.tryConsumeKeyword(KeywordMin) != nil:
+				lifetime.Min, err = p.parseNumber(p.Pos())
+			case p.tryConsumeKeyword(KeywordMax) != nil:
+				lifetime.Max, err = p.parseNumber(p.Pos())
+			default:
+				return nil, fmt.Errorf("expected MIN|MAX, but got %q", p.lastTokenKind())
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	rightParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	lifetime.RParenPos = rightParen.End
+	return lifetime, nil
+}
+
+// RANGE(MIN range_min MAX range_max)
+func (p *Parser) parseDictionaryRangeExpr(pos Pos) (*DictionaryRangeExpr, error) {
+	if err := p.consumeKeyword(KeywordRange); err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordMin); err != nil {
+		return nil, err
+	}
+	rangeMin, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordMax); err != nil {
+		return nil, err
+	}
+	rangeMax, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+	rightParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	return &DictionaryRangeExpr{
+		RangePos:  pos,
+		RParenPos: rightParen.End,
+		Min:       rangeMin,
+		Max:       rangeMax,
+	}, nil
+}
+
+// SETTINGS(setting_name = setting_value, ...)
+func (p *Parser) parseDictionarySettings(pos Pos) (*SettingsExprList, error) {
+	if err := p.consumeKeyword(KeywordSettings); err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	settings, err := p.parseSettingsExprList(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	rightParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	settings.SettingsPos = pos
+	settings.ListEnd = rightParen.End
+	return settings, nil
+}
@@ -1,6 +1,9 @@
 package parser
 
-func (p *Parser) parseDropDatabase(pos Pos) (*DropDatabase, error) {
+import "fmt"
+
+// Syntax: {DROP|DETACH} DATABASE [IF EXISTS] name [ON CLUSTER cluster] [PERMANENTLY] [SYNC|NO DELAY]
+func (p *Parser) parseDropDatabase(pos Pos, isDetach bool) (*DropDatabase, error) {
 	if err := p.consumeKeyword(KeywordDatabase); err != nil {
 		return nil, err
 	}
@@ -25,16 +28,37 @@ func (p *Parser) parseDropDatabase(pos Pos) (*DropDatabase, error) {
 		statementEnd = onCluster.End()
 	}
 
+	var permanently bool
+	if isDetach {
+		if permanentlyToken := p.tryConsumeKeyword(KeywordPermanently); permanentlyToken != nil {
+			permanently = true
+			statementEnd = permanentlyToken.End
+		}
+	}
+
+	modifier, err := p.tryParseModifier()
+	if err != nil {
+		return nil, err
+	}
+	if modifier != "" {
+		statementEnd = p.Pos()
+	}
+
 	return &DropDatabase{
 		DropPos:      pos,
+		IsDetach:     isDetach,
 		Name:         name,
 		IfExists:     isExists,
 		OnCluster:    onCluster,
+		Permanently:  permanently,
+		Modifier:     modifier,
 		StatementEnd: statementEnd,
 	}, nil
 }
 
-func (p *Parser) parseDropStmt(pos Pos) (*DropStmt, error) {
+// Syntax: {DROP|DETACH} [TEMPORARY] {TABLE|VIEW|DICTIONARY} [IF EXISTS] [IF EMPTY] name [, ...]
+// [ON CLUSTER cluster] [PERMANENTLY] [SYNC|NO DELAY]
+func (p *Parser) parseDropStmt(pos Pos, isDetach bool) (*DropStmt, error) {
 	var isTemporary bool
 	dropTarget := KeywordTable
 	switch {
@@ -49,14 +73,29 @@ func (p *Parser) parseDropStmt(pos Pos) (*DropStmt, error) {
 		}
 	}
 
-	isExists, err := p.tryParseIfExists()
-	if err != nil {
-		return nil, err
+	var isExists, isEmpty bool
+	// IF EXISTS comes before IF EMPTY
+	for !isEmpty && p.tryConsumeKeyword(KeywordIf) != nil {
+		switch {
+		case !isExists && p.tryConsumeKeyword(KeywordExists) != nil:
+			isExists = true
+		case !isDetach && p.tryConsumeKeyword(KeywordEmpty) != nil:
+			isEmpty = true
+		default:
+			return nil, fmt.Errorf("expected EXISTS or EMPTY, but got %q", p.lastTokenKind())
+		}
 	}
 
-	name, err := p.parseTableIdentifier(p.Pos())
-	if err != nil {
-		return nil, err
+	var names []*TableIdentifier
+	for {
+		name, err := p.parseTableIdentifier(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
 	}
 
 	onCluster, err := p.tryParseOnCluster(p.Pos())
@@ -64,6 +103,8 @@ func (p *Parser) parseDropStmt(pos Pos) (*DropStmt, error) {
 		return nil, err
 	}
 
+	permanently := isDetach && p.tryConsumeKeyword(KeywordPermanently) != nil
+
 	modifier, err := p.tryParseModifier()
 	if err != nil {
 		return nil, err
@@ -71,16 +112,56 @@ func (p *Parser) parseDropStmt(pos Pos) (*DropStmt, error) {
 
 	return &DropStmt{
 		DropPos:      pos,
+		IsDetach:     isDetach,
 		DropTarget:   dropTarget,
-		Name:         name,
+		Names:        names,
 		IfExists:     isExists,
+		IfEmpty:      isEmpty,
 		OnCluster:    onCluster,
 		IsTemporary:  isTemporary,
+		Permanently:  permanently,
 		Modifier:     modifier,
 		StatementEnd: p.Pos(),
 	}, nil
 }
 
+// Syntax: UNDROP TABLE name [UUID 'uuid'] [ON CLUSTER cluster]
+func (p *Parser) parseUndropStmt(pos Pos) (*UndropStmt, error) {
+	if err := p.consumeKeyword(KeywordUndrop); err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordTable); err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseTableIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	undropStmt := &UndropStmt{
+		UndropPos:    pos,
+		StatementEnd: name.End(),
+		Name:         name,
+	}
+
+	undropStmt.UUID, err = p.tryParseUUID()
+	if err != nil {
+		return nil, err
+	}
+	if undropStmt.UUID != nil {
+		undropStmt.StatementEnd = undropStmt.UUID.End()
+	}
+
+	undropStmt.OnCluster, err = p.tryParseOnCluster(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if undropStmt.OnCluster != nil {
+		undropStmt.StatementEnd = undropStmt.OnCluster.End()
+	}
+	return undropStmt, nil
+}
+
 func (p *Parser) tryParseModifier() (string, error) {
 	switch {
 	case p.tryConsumeKeyword(KeywordSync) != nil:
@@ -93,3 +174,37 @@ func (p *Parser) tryParseModifier() (string, error) {
 	}
 	return "", nil
 }
+
+// Syntax: DROP INDEX [IF EXISTS] name ON table
+func (p *Parser) parseDropIndex(pos Pos) (*DropIndex, error) {
+	if err := p.consumeKeyword(KeywordIndex); err != nil {
+		return nil, err
+	}
+
+	ifExists, err := p.tryParseIfExists()
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := p.ParseNestedIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.consumeKeyword(KeywordOn); err != nil {
+		return nil, err
+	}
+
+	table, err := p.parseTableIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	return &DropIndex{
+		DropPos:      pos,
+		StatementEnd: table.End(),
+		IfExists:     ifExists,
+		Name:         name,
+		Table:        table,
+	}, nil
+}
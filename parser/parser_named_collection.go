@@ -0,0 +1,182 @@
+package parser
+
+import "fmt"
+
+// Syntax: CREATE NAMED COLLECTION [IF NOT EXISTS] name [ON CLUSTER cluster] AS key = value [[NOT] OVERRIDABLE] [, ...]
+func (p *Parser) parseCreateNamedCollection(pos Pos) (*CreateNamedCollection, error) {
+	if err := p.consumeNamedCollection(); err != nil {
+		return nil, err
+	}
+
+	ifNotExists, err := p.tryParseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+
+	onCluster, err := p.tryParseOnCluster(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.consumeKeyword(KeywordAs); err != nil {
+		return nil, err
+	}
+
+	params, err := p.parseNamedCollectionParams()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateNamedCollection{
+		CreatePos:    pos,
+		StatementEnd: params[len(params)-1].End(),
+		IfNotExists:  ifNotExists,
+		Name:         name,
+		OnCluster:    onCluster,
+		Params:       params,
+	}, nil
+}
+
+// Syntax: ALTER NAMED COLLECTION [IF EXISTS] name [ON CLUSTER cluster]
+// [SET key = value [[NOT] OVERRIDABLE] [, ...]] [DELETE key [, ...]]
+func (p *Parser) parseAlterNamedCollection(pos Pos) (*AlterNamedCollection, error) {
+	if err := p.consumeNamedCollection(); err != nil {
+		return nil, err
+	}
+
+	ifExists, err := p.tryParseIfExists()
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+
+	onCluster, err := p.tryParseOnCluster(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	alterNamedCollection := &AlterNamedCollection{
+		AlterPos:  pos,
+		IfExists:  ifExists,
+		Name:      name,
+		OnCluster: onCluster,
+	}
+
+	if p.tryConsumeKeyword(KeywordSet) != nil {
+		alterNamedCollection.Set, err = p.parseNamedCollectionParams()
+		if err != nil {
+			return nil, err
+		}
+		alterNamedCollection.StatementEnd = alterNamedCollection.Set[len(alterNamedCollection.Set)-1].End()
+	}
+
+	if p.tryConsumeKeyword(KeywordDelete) != nil {
+		for {
+			key, err := p.parseIdent()
+			if err != nil {
+				return nil, err
+			}
+			alterNamedCollection.Delete = append(alterNamedCollection.Delete, key)
+			alterNamedCollection.StatementEnd = key.End()
+			if p.tryConsumeTokenKind(",") == nil {
+				break
+			}
+		}
+	}
+
+	if alterNamedCollection.Set == nil && alterNamedCollection.Delete == nil {
+		return nil, fmt.Errorf("expected SET or DELETE, but got %q", p.lastTokenKind())
+	}
+	return alterNamedCollection, nil
+}
+
+// Syntax: DROP NAMED COLLECTION [IF EXISTS] name [ON CLUSTER cluster]
+func (p *Parser) parseDropNamedCollection(pos Pos) (*DropNamedCollection, error) {
+	if err := p.consumeNamedCollection(); err != nil {
+		return nil, err
+	}
+
+	ifExists, err := p.tryParseIfExists()
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+
+	dropNamedCollection := &DropNamedCollection{
+		DropPos:      pos,
+		StatementEnd: name.End(),
+		IfExists:     ifExists,
+		Name:         name,
+	}
+
+	dropNamedCollection.OnCluster, err = p.tryParseOnCluster(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if dropNamedCollection.OnCluster != nil {
+		dropNamedCollection.StatementEnd = dropNamedCollection.OnCluster.End()
+	}
+	return dropNamedCollection, nil
+}
+
+func (p *Parser) consumeNamedCollection() error {
+	if err := p.consumeKeyword(KeywordNamed); err != nil {
+		return err
+	}
+	return p.consumeKeyword(KeywordCollection)
+}
+
+func (p *Parser) parseNamedCollectionParams() ([]*NamedCollectionParam, error) {
+	var params []*NamedCollectionParam
+	for {
+		param, err := p.parseNamedCollectionParam(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, param)
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	return params, nil
+}
+
+// Syntax: key = value [[NOT] OVERRIDABLE]
+func (p *Parser) parseNamedCollectionParam(pos Pos) (*NamedCollectionParam, error) {
+	setting, err := p.parseSettingsExpr(pos)
+	if err != nil {
+		return nil, err
+	}
+	param := &NamedCollectionParam{
+		Setting:  setting,
+		ParamEnd: setting.End(),
+	}
+
+	switch {
+	case p.matchKeyword(KeywordOverridable):
+		param.Overridable = KeywordOverridable
+		param.ParamEnd = p.last().End
+		_ = p.lexer.consumeToken()
+	case p.tryConsumeKeyword(KeywordNot) != nil:
+		lastToken := p.last()
+		if err := p.consumeKeyword(KeywordOverridable); err != nil {
+			return nil, err
+		}
+		param.Overridable = "NOT OVERRIDABLE"
+		param.ParamEnd = lastToken.End
+	}
+	return param, nil
+}
@@ -3,6 +3,7 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 func (p *Parser) tryParseWithExpr(pos Pos) (*WithExpr, error) {
@@ -187,7 +188,7 @@ func (p *Parser) parseJoinOp(_ Pos) []string {
 
 func (p *Parser) parseJoinTableExpr(_ Pos) (Expr, error) {
 	switch {
-	case p.matchTokenKind(TokenIdent), p.matchTokenKind("("):
+	case p.matchTokenKind(TokenIdent), p.matchTokenKind("("), p.matchTokenKind("{"):
 		tableExpr, err := p.parseTableExpr(p.Pos())
 		if err != nil {
 			return nil, err
@@ -286,14 +287,15 @@ func (p *Parser) parseTableExpr(pos Pos) (*TableExpr, error) {
 	var expr Expr
 	var err error
 	switch {
-	case p.matchTokenKind(TokenString), p.matchTokenKind(TokenIdent):
-		// table name
+	case p.matchTokenKind(TokenString), p.matchTokenKind(TokenIdent), p.matchTokenKind("{"):
+		// table name, which can be substituted by the query parameter like {tbl:Identifier}
 		tableIdentifier, err := p.parseTableIdentifier(p.Pos())
 		if err != nil {
 			return nil, err
 		}
 		// it's a table name
-		if tableIdentifier.Database != nil || !p.matchTokenKind("(") { // database.table
+		if tableIdentifier.Table == nil || tableIdentifier.Database != nil ||
+			tableIdentifier.DatabaseParam != nil || !p.matchTokenKind("(") { // database.table
 			expr = tableIdentifier
 		} else {
 			// table function expr
@@ -307,7 +309,7 @@ func (p *Parser) parseTableExpr(pos Pos) (*TableExpr, error) {
 			}
 		}
 	case p.matchTokenKind("("):
-		expr, err = p.parseSelectQuery(p.Pos())
+		expr, err = p.parseSubSelectQuery(p.Pos())
 	default:
 		return nil, errors.New("expect table name or subquery")
 	}
@@ -334,7 +336,7 @@ func (p *Parser) parseTableExpr(pos Pos) (*TableExpr, error) {
 		switch expr.(type) {
 		case *TableFunctionExpr:
 			return nil, errors.New("table function doesn't support FINAL")
-		case *SelectQuery:
+		case *SelectQuery, *SetOperationExpr:
 			return nil, errors.New("subquery doesn't support FINAL")
 		}
 		isFinalExist = true
@@ -408,28 +410,38 @@ func (p *Parser) parseGroupByExpr(pos Pos) (*GroupByExpr, error) {
 		return nil, err
 	}
 
-	var expr Expr
+	groupByExpr := &GroupByExpr{GroupByPos: pos}
 	var err error
-	aggregateType := ""
-	if p.matchKeyword(KeywordCube) || p.matchKeyword(KeywordRollup) {
-		aggregateType = p.last().String
+	switch {
+	case p.matchKeyword(KeywordAll):
+		lastToken := p.last()
 		_ = p.lexer.consumeToken()
-		expr, err = p.parseFunctionParams(p.Pos())
-	} else {
-		expr, err = p.parseColumnExprListWithRoundBracket(p.Pos())
+		groupByExpr.GroupByAll = true
+		groupByExpr.GroupByEnd = lastToken.End
+	case p.matchKeyword(KeywordCube), p.matchKeyword(KeywordRollup), p.matchKeyword(KeywordGrouping):
+		groupByExpr.AggregateType = strings.ToUpper(p.last().String)
+		if p.tryConsumeKeyword(KeywordGrouping) != nil {
+			if err := p.consumeKeyword(KeywordSets); err != nil {
+				return nil, err
+			}
+			groupByExpr.AggregateType = "GROUPING SETS"
+		} else {
+			_ = p.lexer.consumeToken()
+		}
+		groupByExpr.Expr, err = p.parseFunctionParams(p.Pos())
+	default:
+		groupByExpr.Expr, err = p.parseColumnExprListWithRoundBracket(p.Pos())
 	}
 	if err != nil {
 		return nil, err
 	}
-
-	groupByExpr := &GroupByExpr{
-		GroupByPos:    pos,
-		AggregateType: aggregateType,
-		Expr:          expr,
+	if groupByExpr.Expr != nil {
+		groupByExpr.GroupByEnd = groupByExpr.Expr.End()
 	}
 
 	// parse WITH CUBE, ROLLUP, TOTALS
 	for p.tryConsumeKeyword(KeywordWith) != nil {
+		lastToken := p.last()
 		switch {
 		case p.tryConsumeKeyword(KeywordCube) != nil:
 			groupByExpr.WithCube = true
@@ -440,6 +452,7 @@ func (p *Parser) parseGroupByExpr(pos Pos) (*GroupByExpr, error) {
 		default:
 			return nil, fmt.Errorf("expected CUBE, ROLLUP or TOTALS, got %s", p.lastTokenKind())
 		}
+		groupByExpr.GroupByEnd = lastToken.End
 	}
 
 	return groupByExpr, nil
@@ -507,7 +520,7 @@ func (p *Parser) parseLimitByExpr(pos Pos) (Expr, error) {
 }
 
 func (p *Parser) tryParseWindowFrameExpr(pos Pos) (*WindowFrameExpr, error) {
-	if !p.matchKeyword(KeywordRows) && !p.matchKeyword(KeywordRange) {
+	if !p.matchKeyword(KeywordRows) && !p.matchKeyword(KeywordRange) && !p.matchKeyword(KeywordGroups) {
 		return nil, nil
 	}
 	return p.parseWindowFrameExpr(pos)
@@ -515,7 +528,7 @@ func (p *Parser) tryParseWindowFrameExpr(pos Pos) (*WindowFrameExpr, error) {
 
 func (p *Parser) parseWindowFrameExpr(pos Pos) (*WindowFrameExpr, error) {
 	var windowFrameType string
-	if p.matchKeyword(KeywordRows) || p.matchKeyword(KeywordRange) {
+	if p.matchKeyword(KeywordRows) || p.matchKeyword(KeywordRange) || p.matchKeyword(KeywordGroups) {
 		windowFrameType = p.last().String
 		_ = p.lexer.consumeToken()
 	}
@@ -546,28 +559,31 @@ func (p *Parser) parseWindowFrameExpr(pos Pos) (*WindowFrameExpr, error) {
 	case p.matchKeyword(KeywordCurrent):
 		currentPos := p.Pos()
 		_ = p.lexer.consumeToken()
-		rowEnd := p.last().End
-		if err := p.consumeKeyword(KeywordRow); err != nil {
-			return nil, err
+		row := p.tryConsumeKeyword(KeywordRow)
+		if row == nil {
+			return nil, fmt.Errorf("expected ROW, but got %q", p.lastTokenKind())
 		}
 		expr = &WindowFrameCurrentRow{
 			CurrentPos: currentPos,
-			RowEnd:     rowEnd,
+			RowEnd:     row.End,
 		}
 	case p.matchKeyword(KeywordUnbounded):
 		unboundedPos := p.Pos()
 		_ = p.lexer.consumeToken()
 
+		var unboundedEnd Pos
 		direction := ""
 		switch {
 		case p.matchKeyword(KeywordPreceding), p.matchKeyword(KeywordFollowing):
 			direction = p.last().String
+			unboundedEnd = p.last().End
 			_ = p.lexer.consumeToken()
 		default:
 			return nil, fmt.Errorf("expected PRECEDING or FOLLOWING, got %s", p.lastTokenKind())
 		}
 		expr = &WindowFrameUnbounded{
 			UnboundedPos: unboundedPos,
+			UnboundedEnd: unboundedEnd,
 			Direction:    direction,
 		}
 	case p.matchTokenKind(TokenInt):
@@ -612,7 +628,16 @@ func (p *Parser) parseWindowCondition(pos Pos) (*WindowConditionExpr, error) {
 	if _, err := p.consumeTokenKind("("); err != nil {
 		return nil, err
 	}
-	partitionBy, err := p.tryParsePartitionByExpr(pos)
+	// the existing window to extend, like (w ORDER BY x)
+	var name *Ident
+	if p.lastTokenKind() == TokenIdent {
+		var err error
+		name, err = p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+	}
+	partitionBy, err := p.tryParsePartitionByExpr(p.Pos())
 	if err != nil {
 		return nil, err
 	}
@@ -631,38 +656,103 @@ func (p *Parser) parseWindowCondition(pos Pos) (*WindowConditionExpr, error) {
 	return &WindowConditionExpr{
 		LeftParenPos:  pos,
 		RightParenPos: rightParenPos,
+		Name:          name,
 		PartitionBy:   partitionBy,
 		OrderBy:       orderBy,
 		Frame:         frame,
 	}, nil
 }
 
+// WINDOW name AS (window condition) [, ...]
 func (p *Parser) parseWindowExpr(pos Pos) (*WindowExpr, error) {
 	if err := p.consumeKeyword(KeywordWindow); err != nil {
 		return nil, err
 	}
 
-	windowName, err := p.parseIdent()
-	if err != nil {
-		return nil, err
+	windowExpr := &WindowExpr{WindowPos: pos}
+	for {
+		windowName, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+
+		asPos := p.Pos()
+		if err := p.consumeKeyword(KeywordAs); err != nil {
+			return nil, err
+		}
+
+		condition, err := p.parseWindowCondition(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		windowExpr.Definitions = append(windowExpr.Definitions, &WindowDefinition{
+			Name:      windowName,
+			AsPos:     asPos,
+			Condition: condition,
+		})
+		if p.tryConsumeTokenKind(",") == nil {
+			return windowExpr, nil
+		}
 	}
+}
 
-	if err := p.consumeKeyword(KeywordAs); err != nil {
-		return nil, err
+func (p *Parser) tryParseQualifyExpr(pos Pos) (*QualifyExpr, error) {
+	if !p.matchKeyword(KeywordQualify) {
+		return nil, nil
 	}
+	return p.parseQualifyExpr(pos)
+}
 
-	condition, err := p.parseWindowCondition(p.Pos())
+func (p *Parser) parseQualifyExpr(pos Pos) (*QualifyExpr, error) {
+	if err := p.consumeKeyword(KeywordQualify); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseExpr(p.Pos())
 	if err != nil {
 		return nil, err
 	}
-
-	return &WindowExpr{
-		WindowPos:           pos,
-		Name:                windowName,
-		WindowConditionExpr: condition,
+	return &QualifyExpr{
+		QualifyPos: pos,
+		Expr:       expr,
 	}, nil
 }
 
+// resolveWindows links the window names referenced by OVER and the window
+// conditions to the definitions in the WINDOW clause of the query.
+func resolveWindows(selectQuery *SelectQuery) error {
+	definitions := make(map[string]*WindowDefinition)
+	if selectQuery.Window != nil {
+		for _, definition := range selectQuery.Window.Definitions {
+			definitions[definition.Name.Name] = definition
+		}
+	}
+	lookup := func(name *Ident) (*WindowDefinition, error) {
+		definition, ok := definitions[name.Name]
+		if !ok {
+			return nil, fmt.Errorf("window %q is not defined", name.Name)
+		}
+		return definition, nil
+	}
+	visitor := &DefaultASTVisitor{
+		Visit: func(expr Expr) error {
+			var err error
+			switch expr := expr.(type) {
+			case *WindowFunctionExpr:
+				// the windows of subqueries are resolved already
+				if name, ok := expr.OverExpr.(*Ident); ok && expr.Window == nil {
+					expr.Window, err = lookup(name)
+				}
+			case *WindowConditionExpr:
+				if expr.Name != nil && expr.Window == nil {
+					expr.Window, err = lookup(expr.Name)
+				}
+			}
+			return err
+		},
+	}
+	return selectQuery.Accept(visitor)
+}
+
 func (p *Parser) tryParseArrayJoin(pos Pos) (*ArrayJoinExpr, error) {
 	if !p.matchKeyword(KeywordLeft) && !p.matchKeyword(KeywordInner) && !p.matchKeyword(KeywordArray) {
 		return nil, nil
@@ -737,47 +827,143 @@ func (p *Parser) parseSubQuery(pos Pos) (*SubQueryExpr, error) {
 	}, nil
 }
 
-func (p *Parser) parseSelectQuery(_ Pos) (*SelectQuery, error) {
+// parseSelectQuery parses the query with the set operations, the result is
+// *SelectQuery or *SetOperationExpr. The brackets around the whole query are dropped.
+//
+// Syntax: intersectQuery ((UNION | EXCEPT) [ALL | DISTINCT] intersectQuery)*
+func (p *Parser) parseSelectQuery(pos Pos) (Expr, error) {
 	if !p.matchKeyword(KeywordSelect) && !p.matchKeyword(KeywordWith) && !p.matchTokenKind("(") {
 		return nil, fmt.Errorf("expected SELECT, WITH or (, got %s", p.lastTokenKind())
 	}
 
-	hasParen := p.tryConsumeTokenKind("(") != nil
-	selectExpr, err := p.parseSelectStatement(p.Pos())
+	expr, err := p.parseIntersectQuery(pos)
 	if err != nil {
 		return nil, err
 	}
-	switch {
-	case p.tryConsumeKeyword(KeywordUnion) != nil:
-		switch {
-		case p.tryConsumeKeyword(KeywordAll) != nil:
-			unionAllExpr, err := p.parseSelectStatement(p.Pos())
-			if err != nil {
-				return nil, err
-			}
-			selectExpr.UnionAll = unionAllExpr
-		case p.tryConsumeKeyword(KeywordDistinct) != nil:
-			unionDistinctExpr, err := p.parseSelectStatement(p.Pos())
-			if err != nil {
-				return nil, err
-			}
-			selectExpr.UnionDistinct = unionDistinctExpr
-		default:
-			return nil, fmt.Errorf("expected ALL or DISTINCT, got %s", p.lastTokenKind())
+	for p.matchKeyword(KeywordUnion) || p.matchKeyword(KeywordExcept) {
+		setOperation, err := p.parseSetOperator(expr)
+		if err != nil {
+			return nil, err
 		}
-	case p.tryConsumeKeyword(KeywordExcept) != nil:
-		exceptExpr, err := p.parseSelectStatement(p.Pos())
+		setOperation.Right, err = p.parseIntersectQuery(p.Pos())
 		if err != nil {
 			return nil, err
 		}
-		selectExpr.Except = exceptExpr
+		expr = setOperation
+	}
+	if setOperation, ok := expr.(*SetOperationExpr); ok {
+		if err := p.parseSetOperationTail(setOperation); err != nil {
+			return nil, err
+		}
+	}
+	if paren, ok := expr.(*SelectParenExpr); ok {
+		return paren.Query, nil
+	}
+	return expr, nil
+}
+
+// Syntax: selectOperand (INTERSECT [ALL | DISTINCT] selectOperand)*
+func (p *Parser) parseIntersectQuery(pos Pos) (Expr, error) {
+	expr, err := p.parseSelectOperand(pos)
+	if err != nil {
+		return nil, err
 	}
-	if hasParen {
-		if _, err := p.consumeTokenKind(")"); err != nil {
+	for p.matchKeyword(KeywordIntersect) {
+		setOperation, err := p.parseSetOperator(expr)
+		if err != nil {
+			return nil, err
+		}
+		setOperation.Right, err = p.parseSelectOperand(p.Pos())
+		if err != nil {
 			return nil, err
 		}
+		expr = setOperation
+	}
+	return expr, nil
+}
+
+// Syntax: selectStatement | ( selectQuery )
+func (p *Parser) parseSelectOperand(pos Pos) (Expr, error) {
+	if !p.matchTokenKind("(") {
+		return p.parseSelectStatement(pos)
+	}
+	lParen, err := p.consumeTokenKind("(")
+	if err != nil {
+		return nil, err
+	}
+	query, err := p.parseSelectQuery(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	rParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	return &SelectParenExpr{
+		LeftParenPos:  lParen.Pos,
+		RightParenPos: rParen.Pos,
+		Query:         query,
+	}, nil
+}
+
+// parseSetOperator parses the UNION, INTERSECT or EXCEPT with the optional ALL or DISTINCT.
+func (p *Parser) parseSetOperator(left Expr) (*SetOperationExpr, error) {
+	operator := p.last()
+	_ = p.lexer.consumeToken()
+	setOperation := &SetOperationExpr{
+		Left:        left,
+		OperatorPos: operator.Pos,
+		Operator:    strings.ToUpper(operator.String),
+	}
+	if p.matchKeyword(KeywordAll) || p.matchKeyword(KeywordDistinct) {
+		setOperation.Modifier = strings.ToUpper(p.last().String)
+		_ = p.lexer.consumeToken()
+	}
+	return setOperation, nil
+}
+
+// parseSetOperationTail parses the ORDER BY, LIMIT and SETTINGS of the whole set operation,
+// they belong to the last query unless it's in the brackets.
+func (p *Parser) parseSetOperationTail(setOperation *SetOperationExpr) error {
+	last := setOperation
+	for {
+		right, ok := last.Right.(*SetOperationExpr)
+		if !ok {
+			break
+		}
+		last = right
 	}
-	return selectExpr, nil
+	if _, ok := last.Right.(*SelectParenExpr); !ok {
+		return nil
+	}
+
+	var err error
+	setOperation.OrderBy, err = p.tryParseOrderByExprList(p.Pos())
+	if err != nil {
+		return err
+	}
+	setOperation.Limit, err = p.tryParseLimitExpr(p.Pos())
+	if err != nil {
+		return err
+	}
+	setOperation.Settings, err = p.tryParseSettingsExprList(p.Pos())
+	return err
+}
+
+// parseSubSelectQuery parses the query in the brackets which are part of the
+// enclosing expression, like the subquery of FROM, IN and CTE.
+func (p *Parser) parseSubSelectQuery(_ Pos) (Expr, error) {
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return nil, err
+	}
+	query, err := p.parseSelectQuery(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.consumeTokenKind(")"); err != nil {
+		return nil, err
+	}
+	return query, nil
 }
 
 func (p *Parser) parseSelectStatement(pos Pos) (*SelectQuery, error) { // nolint: funlen
@@ -813,13 +999,6 @@ func (p *Parser) parseSelectStatement(pos Pos) (*SelectQuery, error) { // nolint
 	if arrayJoinExpr != nil {
 		statementEnd = arrayJoinExpr.End()
 	}
-	windowExpr, err := p.tryParseWindowExpr(p.Pos())
-	if err != nil {
-		return nil, err
-	}
-	if windowExpr != nil {
-		statementEnd = windowExpr.End()
-	}
 	prewhereExpr, err := p.tryParsePrewhereExpr(p.Pos())
 	if err != nil {
 		return nil, err
@@ -858,6 +1037,20 @@ func (p *Parser) parseSelectStatement(pos Pos) (*SelectQuery, error) { // nolint
 	if havingExpr != nil {
 		statementEnd = havingExpr.End()
 	}
+	windowExpr, err := p.tryParseWindowExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if windowExpr != nil {
+		statementEnd = windowExpr.End()
+	}
+	qualifyExpr, err := p.tryParseQualifyExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if qualifyExpr != nil {
+		statementEnd = qualifyExpr.End()
+	}
 	orderByExpr, err := p.tryParseOrderByExprList(p.Pos())
 	if err != nil {
 		return nil, err
@@ -865,6 +1058,13 @@ func (p *Parser) parseSelectStatement(pos Pos) (*SelectQuery, error) { // nolint
 	if orderByExpr != nil {
 		statementEnd = orderByExpr.End()
 	}
+	interpolateExpr, err := p.tryParseInterpolateExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if interpolateExpr != nil {
+		statementEnd = interpolateExpr.End()
+	}
 
 	var limitByExpr *LimitByExpr
 	var limitExpr *LimitExpr
@@ -897,7 +1097,7 @@ func (p *Parser) parseSelectStatement(pos Pos) (*SelectQuery, error) { // nolint
 		statementEnd = settingsExpr.End()
 	}
 
-	return &SelectQuery{
+	selectQuery := &SelectQuery{
 		With:          withExpr,
 		SelectPos:     pos,
 		StatementEnd:  statementEnd,
@@ -905,17 +1105,23 @@ func (p *Parser) parseSelectStatement(pos Pos) (*SelectQuery, error) { // nolint
 		SelectColumns: selectColumns,
 		From:          fromExpr,
 		ArrayJoin:     arrayJoinExpr,
-		Window:        windowExpr,
 		Prewhere:      prewhereExpr,
 		Where:         whereExpr,
 		GroupBy:       groupByExpr,
 		Having:        havingExpr,
+		Window:        windowExpr,
+		Qualify:       qualifyExpr,
 		OrderBy:       orderByExpr,
+		Interpolate:   interpolateExpr,
 		LimitBy:       limitByExpr,
 		Limit:         limitExpr,
 		Settings:      settingsExpr,
 		WithTotal:     withTotal,
-	}, nil
+	}
+	if err := resolveWindows(selectQuery); err != nil {
+		return nil, err
+	}
+	return selectQuery, nil
 }
 
 func (p *Parser) parseCTEExpr(pos Pos) (*CTEExpr, error) {
@@ -927,7 +1133,7 @@ func (p *Parser) parseCTEExpr(pos Pos) (*CTEExpr, error) {
 		return nil, err
 	}
 	if p.matchTokenKind("(") {
-		selectQuery, err := p.parseSelectQuery(p.Pos())
+		selectQuery, err := p.parseSubSelectQuery(p.Pos())
 		if err != nil {
 			return nil, err
 		}
@@ -1009,29 +1215,96 @@ func (p *Parser) parseSampleRationExpr(pos Pos) (*SampleRatioExpr, error) {
 	}, nil
 }
 
+// explainKinds are the kinds of EXPLAIN in the words, the longer ones come first.
+var explainKinds = []ExplainKind{
+	ExplainKindQueryTree,
+	ExplainKindTableOverride,
+	ExplainKindAST,
+	ExplainKindSyntax,
+	ExplainKindPlan,
+	ExplainKindPipeline,
+	ExplainKindEstimate,
+}
+
+// tryParseExplainKind consumes the words of the EXPLAIN kind if they match.
+func (p *Parser) tryParseExplainKind() (ExplainKind, error) {
+	if !p.matchTokenKind(TokenIdent) {
+		return ExplainKindNone, nil
+	}
+	for _, kind := range explainKinds {
+		words := strings.Fields(string(kind))
+		if !strings.EqualFold(p.last().String, words[0]) {
+			continue
+		}
+		if len(words) > 1 {
+			nextToken, err := p.lexer.peekToken()
+			if err != nil {
+				return ExplainKindNone, err
+			}
+			if nextToken == nil || !strings.EqualFold(nextToken.String, words[1]) {
+				continue
+			}
+			_ = p.lexer.consumeToken()
+		}
+		_ = p.lexer.consumeToken()
+		return kind, nil
+	}
+	return ExplainKindNone, nil
+}
+
+// Syntax: EXPLAIN [AST | SYNTAX | QUERY TREE | PLAN | PIPELINE | ESTIMATE | TABLE OVERRIDE]
+// [setting = value, ...] statement
 func (p *Parser) parseExplainExpr(pos Pos) (*ExplainExpr, error) {
 	if err := p.consumeKeyword(KeywordExplain); err != nil {
 		return nil, err
 	}
 
-	var explainType string
+	kind, err := p.tryParseExplainKind()
+	if err != nil {
+		return nil, err
+	}
+	explainExpr := &ExplainExpr{
+		ExplainPos: pos,
+		Kind:       kind,
+	}
+
+	// the settings are the name = value pairs before the statement
+	for p.matchTokenKind(TokenIdent) {
+		nextToken, err := p.lexer.peekToken()
+		if err != nil {
+			return nil, err
+		}
+		if nextToken == nil || nextToken.Kind != "=" {
+			break
+		}
+		setting, err := p.parseSettingsExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		explainExpr.Settings = append(explainExpr.Settings, setting)
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+
+	var statement Expr
 	switch {
-	case p.matchKeyword(KeywordSyntax),
-		p.matchKeyword(KeywordPipeline),
-		p.matchKeyword(KeywordEstimate),
-		p.matchKeyword(KeywordAst):
-		explainType = p.last().String
-		_ = p.lexer.consumeToken()
+	case kind == ExplainKindTableOverride:
+		statement, err = p.parseTableFunctionCall(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		explainExpr.PartitionBy, err = p.tryParsePartitionByExpr(p.Pos())
+	case p.matchKeyword(KeywordInsert):
+		statement, err = p.parseInsertExpr(p.Pos())
+	case p.matchKeyword(KeywordCreate), p.matchKeyword(KeywordAttach), p.matchKeyword(KeywordAlter):
+		statement, err = p.parseDDL(p.Pos())
 	default:
-		return nil, fmt.Errorf("expected SYNTAX, PIPELINE, ESTIMATE or AST, got %s", p.lastTokenKind())
+		statement, err = p.parseSelectQuery(p.Pos())
 	}
-	expr, err := p.parseSelectQuery(p.Pos())
 	if err != nil {
 		return nil, err
 	}
-	return &ExplainExpr{
-		ExplainPos: pos,
-		Type:       explainType,
-		Statement:  expr,
-	}, nil
+	explainExpr.Statement = statement
+	return explainExpr, nil
 }
@@ -0,0 +1,252 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (p *Parser) parseShowStatement(pos Pos) (Expr, error) {
+	if err := p.consumeKeyword(KeywordShow); err != nil {
+		return nil, err
+	}
+	switch {
+	case p.matchKeyword(KeywordCreate):
+		return p.parseShowCreateExpr(pos)
+	case p.matchKeyword(KeywordGrants):
+		return p.parseShowGrantsExpr(pos)
+	default:
+		return p.parseShowExpr(pos)
+	}
+}
+
+// SHOW [FULL] [TEMPORARY] TABLES|DATABASES|DICTIONARIES|PROCESSLIST [FROM|IN db] [[NOT] LIKE|ILIKE 'pattern' | WHERE expr] [LIMIT n]
+// SHOW [FULL] COLUMNS FROM|IN table [FROM|IN db] [[NOT] LIKE|ILIKE 'pattern' | WHERE expr] [LIMIT n]
+func (p *Parser) parseShowExpr(pos Pos) (*ShowExpr, error) {
+	showExpr := &ShowExpr{ShowPos: pos}
+	if p.tryConsumeKeyword(KeywordFull) != nil {
+		showExpr.Full = true
+	}
+	if p.tryConsumeKeyword(KeywordTemporary) != nil {
+		showExpr.Temporary = true
+	}
+
+	lastToken := p.last()
+	switch {
+	case p.matchKeyword(KeywordTables), p.matchKeyword(KeywordDatabases),
+		p.matchKeyword(KeywordDictionaries), p.matchKeyword(KeywordColumns),
+		p.matchKeyword(KeywordProcesslist):
+		_ = p.lexer.consumeToken()
+		showExpr.Target = strings.ToUpper(lastToken.String)
+		showExpr.StatementEnd = lastToken.End
+	default:
+		return nil, fmt.Errorf("expected TABLES|DATABASES|DICTIONARIES|COLUMNS|PROCESSLIST|CREATE|GRANTS, but got %q", p.lastTokenKind())
+	}
+	if showExpr.Target == KeywordProcesslist {
+		return showExpr, nil
+	}
+
+	var err error
+	if showExpr.Target == KeywordColumns {
+		if p.tryConsumeKeyword(KeywordFrom) == nil && p.tryConsumeKeyword(KeywordIn) == nil {
+			return nil, fmt.Errorf("expected FROM|IN, but got %q", p.lastTokenKind())
+		}
+		showExpr.Table, err = p.parseTableIdentifier(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		showExpr.StatementEnd = showExpr.Table.End()
+	}
+	if showExpr.Target != KeywordDatabases &&
+		(p.tryConsumeKeyword(KeywordFrom) != nil || p.tryConsumeKeyword(KeywordIn) != nil) {
+		showExpr.From, err = p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+		showExpr.StatementEnd = showExpr.From.End()
+	}
+
+	switch {
+	case p.matchKeyword(KeywordNot), p.matchKeyword(KeywordLike), p.matchKeyword(KeywordIlike):
+		showExpr.NotLike = p.tryConsumeKeyword(KeywordNot) != nil
+		lastToken := p.last()
+		if p.tryConsumeKeyword(KeywordLike) == nil && p.tryConsumeKeyword(KeywordIlike) == nil {
+			return nil, fmt.Errorf("expected LIKE|ILIKE, but got %q", p.lastTokenKind())
+		}
+		showExpr.LikeKind = strings.ToUpper(lastToken.String)
+		showExpr.Like, err = p.parseString(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		showExpr.StatementEnd = showExpr.Like.End()
+	case p.matchKeyword(KeywordWhere):
+		showExpr.Where, err = p.parseWhereExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		showExpr.StatementEnd = showExpr.Where.End()
+	}
+
+	showExpr.Limit, err = p.tryParseLimitExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if showExpr.Limit != nil {
+		showExpr.StatementEnd = showExpr.Limit.End()
+	}
+	return showExpr, nil
+}
+
+// parseObjectTarget parses the optional [TEMPORARY] TABLE|DICTIONARY|VIEW|DATABASE
+// prefix of the object name in SHOW CREATE and EXISTS statements.
+func (p *Parser) parseObjectTarget() (bool, string, error) {
+	temporary := p.tryConsumeKeyword(KeywordTemporary) != nil
+	switch {
+	case p.matchKeyword(KeywordTable), p.matchKeyword(KeywordDictionary),
+		p.matchKeyword(KeywordView), p.matchKeyword(KeywordDatabase):
+		target := strings.ToUpper(p.last().String)
+		_ = p.lexer.consumeToken()
+		return temporary, target, nil
+	}
+	if temporary {
+		return false, "", fmt.Errorf("expected TABLE after TEMPORARY, but got %q", p.lastTokenKind())
+	}
+	return false, "", nil
+}
+
+// SHOW CREATE [TEMPORARY] [TABLE|DICTIONARY|VIEW|DATABASE] [db.]name
+func (p *Parser) parseShowCreateExpr(pos Pos) (*ShowCreateExpr, error) {
+	if err := p.consumeKeyword(KeywordCreate); err != nil {
+		return nil, err
+	}
+	temporary, target, err := p.parseObjectTarget()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.parseTableIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &ShowCreateExpr{
+		ShowPos:   pos,
+		Temporary: temporary,
+		Target:    target,
+		Name:      name,
+	}, nil
+}
+
+// SHOW GRANTS [FOR user [,...]] [WITH IMPLICIT] [FINAL]
+func (p *Parser) parseShowGrantsExpr(pos Pos) (*ShowGrantsExpr, error) {
+	lastToken := p.last()
+	if err := p.consumeKeyword(KeywordGrants); err != nil {
+		return nil, err
+	}
+	showGrants := &ShowGrantsExpr{
+		ShowPos:      pos,
+		StatementEnd: lastToken.End,
+	}
+	if p.tryConsumeKeyword(KeywordFor) != nil {
+		names, err := p.parseRoleNames(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		showGrants.For = names
+		showGrants.StatementEnd = names[len(names)-1].End()
+	}
+	if p.tryConsumeKeyword(KeywordWith) != nil {
+		lastToken := p.last()
+		if err := p.consumeKeyword(KeywordImplicit); err != nil {
+			return nil, err
+		}
+		showGrants.WithImplicit = true
+		showGrants.StatementEnd = lastToken.End
+	}
+	if lastToken := p.tryConsumeKeyword(KeywordFinal); lastToken != nil {
+		showGrants.Final = true
+		showGrants.StatementEnd = lastToken.End
+	}
+	return showGrants, nil
+}
+
+// DESC|DESCRIBE [TABLE] [db.]table | table_function(...) | (subquery) [SETTINGS ...]
+func (p *Parser) parseDescribeExpr(pos Pos) (*DescribeExpr, error) {
+	if p.tryConsumeKeyword(KeywordDesc) == nil {
+		if err := p.consumeKeyword(KeywordDescribe); err != nil {
+			return nil, err
+		}
+	}
+	hasTable := p.tryConsumeKeyword(KeywordTable) != nil
+	table, err := p.parseTableExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	settings, err := p.tryParseSettingsExprList(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &DescribeExpr{
+		DescribePos: pos,
+		HasTable:    hasTable,
+		Table:       table,
+		Settings:    settings,
+	}, nil
+}
+
+// EXISTS [TEMPORARY] [TABLE|DICTIONARY|VIEW|DATABASE] [db.]name
+func (p *Parser) parseExistsExpr(pos Pos) (*ExistsExpr, error) {
+	if err := p.consumeKeyword(KeywordExists); err != nil {
+		return nil, err
+	}
+	temporary, target, err := p.parseObjectTarget()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.parseTableIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &ExistsExpr{
+		ExistsPos: pos,
+		Temporary: temporary,
+		Target:    target,
+		Name:      name,
+	}, nil
+}
+
+// KILL QUERY|MUTATION [ON CLUSTER cluster] WHERE expr [SYNC|ASYNC|TEST]
+func (p *Parser) parseKillExpr(pos Pos) (*KillExpr, error) {
+	if err := p.consumeKeyword(KeywordKill); err != nil {
+		return nil, err
+	}
+	var target string
+	switch {
+	case p.tryConsumeKeyword(KeywordQuery) != nil:
+		target = KeywordQuery
+	case p.tryConsumeKeyword(KeywordMutation) != nil:
+		target = KeywordMutation
+	default:
+		return nil, fmt.Errorf("expected QUERY|MUTATION, but got %q", p.lastTokenKind())
+	}
+	onCluster, err := p.tryParseOnCluster(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	where, err := p.parseWhereExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	killExpr := &KillExpr{
+		KillPos:      pos,
+		StatementEnd: where.End(),
+		Target:       target,
+		OnCluster:    onCluster,
+		Where:        where,
+	}
+	switch {
+	case p.matchKeyword(KeywordSync), p.matchKeyword(KeywordAsync), p.matchKeyword(KeywordTest):
+		lastToken := p.last()
+		_ = p.lexer.consumeToken()
+		killExpr.Modifier = strings.ToUpper(lastToken.String)
+		killExpr.StatementEnd = lastToken.End
+	}
+	return killExpr, nil
+}
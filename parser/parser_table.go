@@ -1,7 +1,9 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 )
 
 func (p *Parser) parseDDL(pos Pos) (DDL, error) {
@@ -21,16 +23,31 @@ func (p *Parser) parseDDL(pos Pos) (DDL, error) {
 			return p.parseCreateMaterializedView(pos)
 		case p.matchKeyword(KeywordLive):
 			return p.parseCreateLiveView(pos)
+		case p.matchKeyword(KeywordWindow):
+			return p.parseCreateWindowView(pos)
 		case p.matchKeyword(KeywordView):
 			return p.parseCreateView(pos)
 		case p.matchKeyword(KeywordRole):
 			return p.parseCreateRole(pos)
-		case p.matchKeyword(KeywordDictionary):
-		case p.matchKeyword(KeywordFunction):
-		case p.matchKeyword(KeywordRow):
-		case p.matchKeyword(KeywordSettings):
+		case p.matchKeyword(KeywordDictionary),
+			p.matchKeyword(KeywordOr):
+			return p.parseCreateDictionary(pos)
+		case p.matchKeyword(KeywordUser):
+			return p.parseCreateUser(pos)
+		case p.matchKeyword(KeywordQuota):
+			return p.parseCreateQuota(pos)
+		case p.matchKeyword(KeywordRow),
+			p.matchKeyword(KeywordPolicy):
+			return p.parseCreateRowPolicy(pos)
+		case p.matchKeyword(KeywordSettings),
+			p.matchKeyword(KeywordProfile):
+			return p.parseCreateSettingsProfile(pos)
+		case p.matchKeyword(KeywordIndex):
+			return p.parseCreateIndex(pos)
+		case p.matchKeyword(KeywordNamed):
+			return p.parseCreateNamedCollection(pos)
 		default:
-			return nil, fmt.Errorf("expected keyword: DATABASE|TABLE|VIEW|DICTIONARY|FUNCTION|ROW|QUOTA|SETTINGS, but got %q",
+			return nil, fmt.Errorf("expected keyword: DATABASE|TABLE|VIEW|DICTIONARY|FUNCTION|ROLE|USER|ROW|QUOTA|SETTINGS|INDEX|NAMED, but got %q",
 				p.last().String)
 		}
 	case p.matchKeyword(KeywordAlter):
@@ -38,25 +55,47 @@ func (p *Parser) parseDDL(pos Pos) (DDL, error) {
 		switch {
 		case p.matchKeyword(KeywordRole):
 			return p.parseAlterRole(pos)
+		case p.matchKeyword(KeywordUser):
+			return p.parseAlterUser(pos)
+		case p.matchKeyword(KeywordQuota):
+			return p.parseAlterQuota(pos)
+		case p.matchKeyword(KeywordRow),
+			p.matchKeyword(KeywordPolicy):
+			return p.parseAlterRowPolicy(pos)
+		case p.matchKeyword(KeywordSettings),
+			p.matchKeyword(KeywordProfile):
+			return p.parseAlterSettingsProfile(pos)
 		case p.matchKeyword(KeywordTable):
 			return p.parseAlterTable(pos)
+		case p.matchKeyword(KeywordNamed):
+			return p.parseAlterNamedCollection(pos)
 		default:
-			return nil, fmt.Errorf("expected keyword: TABLE|ROLE, but got %q", p.last().String)
+			return nil, fmt.Errorf("expected keyword: TABLE|ROLE|USER|QUOTA|ROW|SETTINGS|NAMED, but got %q", p.lastTokenKind())
 		}
 	case p.matchKeyword(KeywordDrop),
 		p.matchKeyword(KeywordDetach):
+		isDetach := p.matchKeyword(KeywordDetach)
 		_ = p.lexer.consumeToken()
 		switch {
 		case p.matchKeyword(KeywordDatabase):
-			return p.parseDropDatabase(pos)
+			return p.parseDropDatabase(pos, isDetach)
 		case p.matchKeyword(KeywordTemporary),
 			p.matchKeyword(KeywordView),
 			p.matchKeyword(KeywordDictionary),
 			p.matchKeyword(KeywordTable):
-			return p.parseDropStmt(pos)
+			return p.parseDropStmt(pos, isDetach)
 		case p.matchKeyword(KeywordUser),
-			p.matchKeyword(KeywordRole):
+			p.matchKeyword(KeywordRole),
+			p.matchKeyword(KeywordQuota),
+			p.matchKeyword(KeywordRow),
+			p.matchKeyword(KeywordPolicy),
+			p.matchKeyword(KeywordSettings),
+			p.matchKeyword(KeywordProfile):
 			return p.parserDropUserOrRole(pos)
+		case !isDetach && p.matchKeyword(KeywordIndex):
+			return p.parseDropIndex(pos)
+		case !isDetach && p.matchKeyword(KeywordNamed):
+			return p.parseDropNamedCollection(pos)
 		default:
 			return nil, fmt.Errorf("expected keyword: DATABASE|TABLE, but got %q", p.last().String)
 		}
@@ -64,6 +103,10 @@ func (p *Parser) parseDDL(pos Pos) (DDL, error) {
 		return p.parseTruncateTable(pos)
 	case p.matchKeyword(KeywordRename):
 		return p.parseRenameStmt(pos)
+	case p.matchKeyword(KeywordExchange):
+		return p.parseExchangeStmt(pos)
+	case p.matchKeyword(KeywordUndrop):
+		return p.parseUndropStmt(pos)
 	}
 	return nil, nil // nolint
 }
@@ -158,6 +201,22 @@ func (p *Parser) parseCreateTable(pos Pos) (*CreateTable, error) {
 		createTable.StatementEnd = engineExpr.End()
 	}
 
+	comment, err := p.tryParseColumnComment(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if comment != nil {
+		createTable.Comment = comment
+		createTable.StatementEnd = comment.End()
+	}
+
+	if emptyToken := p.tryConsumeKeyword(KeywordEmpty); emptyToken != nil {
+		createTable.Empty = true
+		createTable.StatementEnd = emptyToken.End
+		if !p.matchKeyword(KeywordAs) {
+			return nil, fmt.Errorf("expected AS after EMPTY, but got %q", p.lastTokenKind())
+		}
+	}
 	if p.matchKeyword(KeywordAs) {
 		subQuery, err := p.parseSubQuery(p.Pos())
 		if err != nil {
@@ -175,15 +234,6 @@ func (p *Parser) parseIdentOrFunction(_ Pos) (Expr, error) {
 		return nil, err
 	}
 	switch {
-	case p.matchTokenKind("["):
-		params, err := p.parseArrayParams(p.Pos())
-		if err != nil {
-			return nil, err
-		}
-		return &ObjectParams{
-			Object: ident,
-			Params: params,
-		}, nil
 	case p.matchTokenKind("("):
 		params, err := p.parseFunctionParams(p.Pos())
 		if err != nil {
@@ -193,6 +243,9 @@ func (p *Parser) parseIdentOrFunction(_ Pos) (Expr, error) {
 			Name:   ident,
 			Params: params,
 		}
+		if err := p.parseFunctionModifiers(funcExpr); err != nil {
+			return nil, err
+		}
 		if overToken := p.tryConsumeKeyword(KeywordOver); overToken != nil {
 			var overExpr Expr
 			switch {
@@ -217,14 +270,16 @@ func (p *Parser) parseIdentOrFunction(_ Pos) (Expr, error) {
 			}, nil
 		}
 		return funcExpr, nil
-	case p.tryConsumeTokenKind(".") != nil:
+	case p.matchDotIdent(), p.matchDotStar():
+		_ = p.lexer.consumeToken()
 		switch {
 		case p.matchTokenKind(TokenIdent):
 			nextIdent, err := p.parseIdent()
 			if err != nil {
 				return nil, err
 			}
-			if p.tryConsumeTokenKind(".") != nil {
+			if p.matchDotIdent() {
+				_ = p.lexer.consumeToken()
 				thirdIdent, err := p.parseIdent()
 				if err != nil {
 					return nil, err
@@ -254,26 +309,97 @@ func (p *Parser) parseIdentOrFunction(_ Pos) (Expr, error) {
 	return ident, nil
 }
 
-func (p *Parser) parseTableIdentifier(_ Pos) (*TableIdentifier, error) {
-	ident, err := p.parseIdent()
+// matchDotIdent reports whether the next tokens are `.ident`, the tuple
+// elements and the subcolumn type hints are left to the postfix expression.
+func (p *Parser) matchDotIdent() bool {
+	if !p.matchTokenKind(".") {
+		return false
+	}
+	nextToken, err := p.lexer.peekToken()
+	if err != nil || nextToken == nil {
+		return false
+	}
+	return nextToken.Kind == TokenIdent || nextToken.Kind == TokenKeyword
+}
+
+func (p *Parser) matchDotStar() bool {
+	if !p.matchTokenKind(".") {
+		return false
+	}
+	nextToken, err := p.lexer.peekToken()
+	return err == nil && nextToken != nil && nextToken.Kind == "*"
+}
+
+// parseFunctionModifiers parses the [RESPECT|IGNORE NULLS] [FILTER (WHERE cond)] after the function.
+func (p *Parser) parseFunctionModifiers(funcExpr *FunctionExpr) error {
+	if p.matchKeyword(KeywordRespect) || p.matchKeyword(KeywordIgnore) {
+		funcExpr.Nulls = strings.ToUpper(p.last().String)
+		_ = p.lexer.consumeToken()
+		if err := p.consumeKeyword(KeywordNulls); err != nil {
+			return err
+		}
+	}
+	if !p.matchKeyword(KeywordFilter) {
+		return nil
+	}
+	filterPos := p.Pos()
+	_ = p.lexer.consumeToken()
+	if _, err := p.consumeTokenKind("("); err != nil {
+		return err
+	}
+	if err := p.consumeKeyword(KeywordWhere); err != nil {
+		return err
+	}
+	condition, err := p.parseExpr(p.Pos())
 	if err != nil {
-		return nil, err
+		return err
+	}
+	rightParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return err
+	}
+	funcExpr.Filter = &FilterExpr{
+		FilterPos:     filterPos,
+		RightParenPos: rightParen.End,
+		Condition:     condition,
 	}
-	dotIdent, err := p.tryParseDotIdent(p.Pos())
+	return nil
+}
+
+func (p *Parser) parseTableIdentifier(_ Pos) (*TableIdentifier, error) {
+	ident, param, err := p.parseIdentOrQueryParam()
 	if err != nil {
 		return nil, err
 	}
-	if dotIdent != nil {
+	if p.tryConsumeTokenKind(".") == nil {
 		return &TableIdentifier{
-			Database: ident,
-			Table:    dotIdent,
+			Table:      ident,
+			TableParam: param,
 		}, nil
 	}
+	dotIdent, dotParam, err := p.parseIdentOrQueryParam()
+	if err != nil {
+		return nil, err
+	}
 	return &TableIdentifier{
-		Table: ident,
+		Database:      ident,
+		DatabaseParam: param,
+		Table:         dotIdent,
+		TableParam:    dotParam,
 	}, nil
 }
 
+// parseIdentOrQueryParam parses the name of database or table, which can be substituted by
+// the query parameter like {tbl:Identifier}.
+func (p *Parser) parseIdentOrQueryParam() (*Ident, *QueryParam, error) {
+	if p.matchTokenKind("{") {
+		param, err := p.parseQueryParam(p.Pos())
+		return nil, param, err
+	}
+	ident, err := p.parseIdent()
+	return ident, nil, err
+}
+
 func (p *Parser) parseTableSchemaExpr(pos Pos) (*TableSchemaExpr, error) {
 	switch {
 	case p.matchTokenKind("("):
@@ -296,57 +422,74 @@ func (p *Parser) parseTableSchemaExpr(pos Pos) (*TableSchemaExpr, error) {
 			SchemaEnd: rightParenPos,
 			Columns:   columns,
 		}, nil
-	case p.tryConsumeKeyword(KeywordAs) != nil:
-		switch {
-		case p.matchTokenKind(TokenIdent):
-			ident, err := p.parseIdent()
-			if err != nil {
-				return nil, err
-			}
-			switch {
-			case p.matchTokenKind("."):
-				// it's a database.table
-				dotIdent, err := p.tryParseDotIdent(p.Pos())
-				if err != nil {
-					return nil, err
-				}
-				return &TableSchemaExpr{
-					SchemaPos: pos,
-					SchemaEnd: dotIdent.End(),
-					AliasTable: &TableIdentifier{
-						Database: ident,
-						Table:    dotIdent,
-					},
-				}, nil
-			case p.matchTokenKind("("):
-				// it's a table function
-				argsExpr, err := p.parseTableArgList(pos)
-				if err != nil {
-					return nil, err
-				}
-				return &TableSchemaExpr{
-					SchemaPos: pos,
-					SchemaEnd: p.last().End,
-					TableFunction: &TableFunctionExpr{
-						Name: ident,
-						Args: argsExpr,
-					},
-				}, nil
-			default:
-				return &TableSchemaExpr{
-					SchemaPos: pos,
-					SchemaEnd: p.last().End,
-					AliasTable: &TableIdentifier{
-						Table: ident,
-					},
-				}, nil
-			}
+	case p.matchKeyword(KeywordAs):
+		_ = p.lexer.consumeToken()
+		return p.parseTableSchemaAs(pos)
+	case p.matchKeyword(KeywordClone):
+		_ = p.lexer.consumeToken()
+		if err := p.consumeKeyword(KeywordAs); err != nil {
+			return nil, err
 		}
+		schema, err := p.parseTableSchemaAs(pos)
+		if err != nil {
+			return nil, err
+		}
+		if schema.AliasTable == nil {
+			return nil, errors.New("expected table name after CLONE AS")
+		}
+		schema.Clone = true
+		return schema, nil
 	}
 	// no schema is ok for MATERIALIZED VIEW
 	return nil, nil
 }
 
+// Syntax: AS [database.]table | AS tableFunction(...)
+func (p *Parser) parseTableSchemaAs(pos Pos) (*TableSchemaExpr, error) {
+	ident, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case p.matchTokenKind("."):
+		// it's a database.table
+		dotIdent, err := p.tryParseDotIdent(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		return &TableSchemaExpr{
+			SchemaPos: pos,
+			SchemaEnd: dotIdent.End(),
+			AliasTable: &TableIdentifier{
+				Database: ident,
+				Table:    dotIdent,
+			},
+		}, nil
+	case p.matchTokenKind("("):
+		// it's a table function
+		argsExpr, err := p.parseTableArgList(pos)
+		if err != nil {
+			return nil, err
+		}
+		return &TableSchemaExpr{
+			SchemaPos: pos,
+			SchemaEnd: argsExpr.End(),
+			TableFunction: &TableFunctionExpr{
+				Name: ident,
+				Args: argsExpr,
+			},
+		}, nil
+	default:
+		return &TableSchemaExpr{
+			SchemaPos: pos,
+			SchemaEnd: ident.End(),
+			AliasTable: &TableIdentifier{
+				Table: ident,
+			},
+		}, nil
+	}
+}
+
 func (p *Parser) parseTableColumns() ([]Expr, error) {
 	columns := make([]Expr, 0)
 	for !p.lexer.isEOF() {
@@ -359,25 +502,22 @@ func (p *Parser) parseTableColumns() ([]Expr, error) {
 				return nil, err
 			}
 			columns = append(columns, index)
-		case p.matchKeyword(KeywordConstraint):
-			constraintPos := p.Pos()
+		case p.matchKeyword(KeywordProjection):
+			projectionPos := p.Pos()
 			_ = p.lexer.consumeToken()
-			ident, err := p.parseIdent()
+			projection, err := p.parseTableProjection(projectionPos)
 			if err != nil {
 				return nil, err
 			}
-			if err := p.consumeKeyword(KeywordCheck); err != nil {
-				return nil, err
-			}
-			expr, err := p.parseExpr(p.Pos())
+			columns = append(columns, projection)
+		case p.matchKeyword(KeywordConstraint):
+			constraintPos := p.Pos()
+			_ = p.lexer.consumeToken()
+			constraint, err := p.parseConstraintExpr(constraintPos)
 			if err != nil {
 				return nil, err
 			}
-			columns = append(columns, &ConstraintExpr{
-				ConstraintPos: constraintPos,
-				Constraint:    ident,
-				Expr:          expr,
-			})
+			columns = append(columns, constraint)
 		default:
 			column, err := p.tryParseTableColumn(p.Pos())
 			if err != nil {
@@ -459,6 +599,31 @@ func (p *Parser) parseTableColumn(pos Pos) (*Column, error) {
 		columnEnd = codec.End()
 	}
 
+	if p.tryConsumeKeyword(KeywordTtl) != nil {
+		ttlExpr, err := p.parseExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		column.TTL = &TTLExpr{
+			TTLPos: ttlExpr.Pos(),
+			TTLEnd: ttlExpr.End(),
+			Expr:   ttlExpr,
+			Action: TTLActionNone,
+		}
+		columnEnd = ttlExpr.End()
+	}
+
+	// the comment is also accepted after the TTL, which is where it's formatted
+	if comment == nil {
+		comment, err = p.tryParseColumnComment(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		if comment != nil {
+			columnEnd = comment.End()
+		}
+	}
+
 	column.ColumnEnd = columnEnd
 	column.Comment = comment
 	column.Codec = codec
@@ -501,7 +666,7 @@ func (p *Parser) parseTableArgExpr(pos Pos) (Expr, error) {
 	case p.matchTokenKind(TokenInt), p.matchTokenKind(TokenString), p.matchKeyword("NULL"):
 		return p.parseLiteral(p.Pos())
 	default:
-		return nil, fmt.Errorf("unexpected token: %q, expected <Ident>, <literal>", p.last().String)
+		return nil, fmt.Errorf("unexpected token: %q, expected <Ident>, <literal>", p.lastTokenKind())
 	}
 }
 
@@ -550,7 +715,7 @@ func (p *Parser) tryParseOnCluster(pos Pos) (*OnClusterExpr, error) {
 	case p.matchTokenKind(TokenString):
 		expr, err = p.parseString(p.Pos())
 	default:
-		return nil, fmt.Errorf("unexpected token: %q, expected <IDENT> or <STRING>", p.last().String)
+		return nil, fmt.Errorf("unexpected token: %q, expected <IDENT> or <STRING>", p.lastTokenKind())
 	}
 	if err != nil {
 		return nil, err
@@ -652,11 +817,120 @@ func (p *Parser) parseOrderByExpr(pos Pos) (*OrderByExpr, error) {
 		direction = OrderDirectionDesc
 		_ = p.lexer.consumeToken()
 	}
-	return &OrderByExpr{
+	orderByExpr := &OrderByExpr{
 		OrderPos:  pos,
 		Expr:      columnExpr,
 		Direction: direction,
-	}, nil
+	}
+
+	if p.tryConsumeKeyword(KeywordNulls) != nil {
+		switch {
+		case p.tryConsumeKeyword(KeywordFirst) != nil:
+			orderByExpr.Nulls = KeywordFirst
+		case p.tryConsumeKeyword(KeywordLast) != nil:
+			orderByExpr.Nulls = KeywordLast
+		default:
+			return nil, fmt.Errorf("expected FIRST or LAST, got %s", p.lastTokenKind())
+		}
+	}
+	if p.tryConsumeKeyword(KeywordCollate) != nil {
+		orderByExpr.Collate, err = p.parseString(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.matchKeyword(KeywordWith) {
+		if nextToken, _ := p.lexer.peekToken(); nextToken != nil && strings.EqualFold(nextToken.String, KeywordFill) {
+			orderByExpr.WithFill, err = p.parseWithFillExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return orderByExpr, nil
+}
+
+// WITH FILL [FROM expr] [TO expr] [STEP expr]
+func (p *Parser) parseWithFillExpr(pos Pos) (*WithFillExpr, error) {
+	if err := p.consumeKeyword(KeywordWith); err != nil {
+		return nil, err
+	}
+	lastToken := p.last()
+	if err := p.consumeKeyword(KeywordFill); err != nil {
+		return nil, err
+	}
+	withFill := &WithFillExpr{
+		WithPos: pos,
+		FillEnd: lastToken.End,
+	}
+	var err error
+	if p.tryConsumeKeyword(KeywordFrom) != nil {
+		withFill.From, err = p.parseExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		withFill.FillEnd = withFill.From.End()
+	}
+	if p.tryConsumeKeyword(KeywordTo) != nil {
+		withFill.To, err = p.parseExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		withFill.FillEnd = withFill.To.End()
+	}
+	if p.tryConsumeKeyword(KeywordStep) != nil {
+		withFill.Step, err = p.parseExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		withFill.FillEnd = withFill.Step.End()
+	}
+	return withFill, nil
+}
+
+func (p *Parser) tryParseInterpolateExpr(pos Pos) (*InterpolateExpr, error) {
+	if !p.matchKeyword(KeywordInterpolate) {
+		return nil, nil // nolint
+	}
+	return p.parseInterpolateExpr(pos)
+}
+
+// INTERPOLATE [(col [AS expr], ...)]
+func (p *Parser) parseInterpolateExpr(pos Pos) (*InterpolateExpr, error) {
+	lastToken := p.last()
+	if err := p.consumeKeyword(KeywordInterpolate); err != nil {
+		return nil, err
+	}
+	interpolate := &InterpolateExpr{
+		InterpolatePos: pos,
+		ListEnd:        lastToken.End,
+	}
+	if p.tryConsumeTokenKind("(") == nil {
+		return interpolate, nil
+	}
+	for !p.matchTokenKind(")") {
+		column, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+		item := &InterpolateItem{Column: column}
+		if p.tryConsumeKeyword(KeywordAs) != nil {
+			item.Expr, err = p.parseExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+		}
+		interpolate.Items = append(interpolate.Items, item)
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	rightParen, err := p.consumeTokenKind(")")
+	if err != nil {
+		return nil, err
+	}
+	interpolate.ListEnd = rightParen.End
+	return interpolate, nil
 }
 
 func (p *Parser) tryParseTTLExprList(pos Pos) (*TTLExprList, error) {
@@ -678,13 +952,13 @@ func (p *Parser) tryParseTTLExprList(pos Pos) (*TTLExprList, error) {
 
 func (p *Parser) parseTTLExprList(pos Pos) ([]*TTLExpr, error) {
 	items := make([]*TTLExpr, 0)
-	expr, err := p.parseTTLExpr(pos)
+	expr, err := p.parseTTLExpr(p.Pos())
 	if err != nil {
 		return nil, err
 	}
 	items = append(items, expr)
 	for !p.lexer.isEOF() && p.tryConsumeTokenKind(",") != nil {
-		expr, err = p.parseTTLExpr(pos)
+		expr, err = p.parseTTLExpr(p.Pos())
 		if err != nil {
 			return nil, err
 		}
@@ -693,29 +967,113 @@ func (p *Parser) parseTTLExprList(pos Pos) ([]*TTLExpr, error) {
 	return items, nil
 }
 
+// Syntax: expr [DELETE | TO DISK 'disk' | TO VOLUME 'volume' | RECOMPRESS CODEC(codec)] [WHERE cond]
+// or: expr GROUP BY key [, ...] [SET column = aggregate [, ...]]
 func (p *Parser) parseTTLExpr(pos Pos) (*TTLExpr, error) {
 	columnExpr, err := p.parseExpr(pos)
 	if err != nil {
 		return nil, err
 	}
+	ttlExpr := &TTLExpr{
+		TTLPos: pos,
+		TTLEnd: columnExpr.End(),
+		Expr:   columnExpr,
+		Action: TTLActionNone,
+	}
 	switch {
 	case p.matchKeyword(KeywordDelete):
+		ttlExpr.Action = TTLActionDelete
+		ttlExpr.TTLEnd = p.last().End
 		_ = p.lexer.consumeToken()
 	case p.matchKeyword(KeywordTo):
 		_ = p.lexer.consumeToken()
-		if p.tryConsumeKeyword(KeywordDisk) != nil || p.tryConsumeKeyword(KeywordVolume) != nil {
-			_, err := p.parseString(p.Pos())
+		switch {
+		case p.tryConsumeKeyword(KeywordDisk) != nil:
+			ttlExpr.Action = TTLActionToDisk
+		case p.tryConsumeKeyword(KeywordVolume) != nil:
+			ttlExpr.Action = TTLActionToVolume
+		default:
+			return nil, fmt.Errorf("expected keyword <DISK> or <VOLUME>, but got %q", p.lastTokenKind())
+		}
+		target, err := p.parseString(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		ttlExpr.Target = target
+		ttlExpr.TTLEnd = target.End()
+	case p.matchKeyword(KeywordRecompress):
+		_ = p.lexer.consumeToken()
+		codec, err := p.tryParseCompressionCodecs(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		if codec == nil {
+			return nil, fmt.Errorf("expected keyword <CODEC>, but got %q", p.lastTokenKind())
+		}
+		ttlExpr.Action = TTLActionRecompress
+		ttlExpr.Codec = codec
+		ttlExpr.TTLEnd = codec.End()
+	case p.matchKeyword(KeywordGroup):
+		if err := p.parseTTLGroupBy(ttlExpr); err != nil {
+			return nil, err
+		}
+		return ttlExpr, nil
+	}
+
+	// WHERE only applies to the rows deletion
+	if ttlExpr.Action == TTLActionNone || ttlExpr.Action == TTLActionDelete {
+		if p.tryConsumeKeyword(KeywordWhere) != nil {
+			where, err := p.parseExpr(p.Pos())
 			if err != nil {
 				return nil, err
 			}
-		} else {
-			return nil, fmt.Errorf("expected keyword <DISK> or <VOLUME>, but got %q", p.last().String)
+			ttlExpr.Where = where
+			ttlExpr.TTLEnd = where.End()
 		}
 	}
-	return &TTLExpr{
-		TTLPos: pos,
-		Expr:   columnExpr,
-	}, nil
+	return ttlExpr, nil
+}
+
+func (p *Parser) parseTTLGroupBy(ttlExpr *TTLExpr) error {
+	if err := p.consumeKeyword(KeywordGroup); err != nil {
+		return err
+	}
+	if err := p.consumeKeyword(KeywordBy); err != nil {
+		return err
+	}
+	ttlExpr.Action = TTLActionGroupBy
+	for {
+		key, err := p.parseExpr(p.Pos())
+		if err != nil {
+			return err
+		}
+		ttlExpr.GroupBy = append(ttlExpr.GroupBy, key)
+		ttlExpr.TTLEnd = key.End()
+		if p.tryConsumeTokenKind(",") == nil {
+			break
+		}
+	}
+	if p.tryConsumeKeyword(KeywordSet) == nil {
+		return nil
+	}
+	for {
+		assignment, err := p.parseUpdateAssignment(p.Pos())
+		if err != nil {
+			return err
+		}
+		ttlExpr.Set = append(ttlExpr.Set, assignment)
+		ttlExpr.TTLEnd = assignment.End()
+		// the comma is followed by either the next assignment or the next TTL rule
+		if !p.matchTokenKind(",") {
+			return nil
+		}
+		tokens, err := p.lexer.peekTokens(2)
+		if err != nil || len(tokens) < 2 || tokens[1].Kind != "=" ||
+			(tokens[0].Kind != TokenIdent && tokens[0].Kind != TokenKeyword) {
+			return nil
+		}
+		_ = p.lexer.consumeToken()
+	}
 }
 
 func (p *Parser) tryParseSampleByExpr(pos Pos) (*SampleByExpr, error) {
@@ -791,8 +1149,21 @@ func (p *Parser) parseSettingsExpr(pos Pos) (*SettingsExpr, error) {
 		if err != nil {
 			return nil, err
 		}
+	case p.matchKeyword(KeywordTrue), p.matchKeyword(KeywordFalse):
+		boolean, err := p.parseIdent()
+		if err != nil {
+			return nil, err
+		}
+		expr = boolean
+	case p.matchTokenKind(TokenIdent):
+		// the engine like base_backup = Disk('backups', 'base.zip')
+		function, err := p.parseTableFunctionCall(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		expr = function
 	default:
-		return nil, fmt.Errorf("unexpected token: %q, expected <number> or <string>", p.last().String)
+		return nil, fmt.Errorf("unexpected token: %q, expected <number> or <string>", p.lastTokenKind())
 	}
 
 	return &SettingsExpr{
@@ -933,12 +1304,16 @@ func (p *Parser) parseStatement(pos Pos) (Expr, error) {
 		p.matchKeyword(KeywordDrop),
 		p.matchKeyword(KeywordDetach),
 		p.matchKeyword(KeywordTruncate),
-		p.matchKeyword(KeywordRename):
+		p.matchKeyword(KeywordRename),
+		p.matchKeyword(KeywordExchange),
+		p.matchKeyword(KeywordUndrop):
 		expr, err = p.parseDDL(pos)
-	case p.matchKeyword(KeywordSelect), p.matchKeyword(KeywordWith):
+	case p.matchKeyword(KeywordSelect), p.matchKeyword(KeywordWith), p.matchTokenKind("("):
 		expr, err = p.parseSelectQuery(pos)
 	case p.matchKeyword(KeywordDelete):
 		expr, err = p.parseDeleteFrom(pos)
+	case p.matchKeyword(KeywordUpdate):
+		expr, err = p.parseUpdate(pos)
 	case p.matchKeyword(KeywordInsert):
 		expr, err = p.parseInsertExpr(p.Pos())
 	case p.matchKeyword(KeywordUse):
@@ -955,16 +1330,46 @@ func (p *Parser) parseStatement(pos Pos) (Expr, error) {
 		expr, err = p.parseExplainExpr(pos)
 	case p.matchKeyword(KeywordGrant):
 		expr, err = p.parseGrantPrivilege(pos)
+	case p.matchKeyword(KeywordRevoke):
+		expr, err = p.parseRevoke(pos)
+	case p.matchKeyword(KeywordShow):
+		expr, err = p.parseShowStatement(pos)
+	case p.matchKeyword(KeywordDesc), p.matchKeyword(KeywordDescribe):
+		expr, err = p.parseDescribeExpr(pos)
+	case p.matchKeyword(KeywordExists):
+		expr, err = p.parseExistsExpr(pos)
+	case p.matchKeyword(KeywordKill):
+		expr, err = p.parseKillExpr(pos)
+	case p.matchKeyword(KeywordWatch):
+		expr, err = p.parseWatchExpr(pos)
+	case p.matchKeyword(KeywordBackup):
+		expr, err = p.parseBackupExpr(pos)
+	case p.matchKeyword(KeywordRestore):
+		expr, err = p.parseRestoreExpr(pos)
 	default:
 		return nil, fmt.Errorf("unexpected token: %q", p.last().String)
 	}
 	if err != nil {
 		return nil, err
 	}
-	_, err = p.tryParseFormatExpr(p.Pos())
+	intoOutfile, err := p.tryParseIntoOutfileExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if intoOutfile != nil {
+		if err := attachIntoOutfile(expr, intoOutfile); err != nil {
+			return nil, err
+		}
+	}
+	format, err := p.tryParseFormatExpr(p.Pos())
 	if err != nil {
 		return nil, err
 	}
+	if format != nil {
+		if err := attachFormat(expr, format); err != nil {
+			return nil, err
+		}
+	}
 
 	// Statement can be terminated by ';' or EOF
 	if p.last() != nil && !p.matchTokenKind(";") {
@@ -973,6 +1378,60 @@ func (p *Parser) parseStatement(pos Pos) (Expr, error) {
 	return expr, nil
 }
 
+// attachIntoOutfile sets the INTO OUTFILE clause to the query which outputs the result.
+func attachIntoOutfile(expr Expr, intoOutfile *IntoOutfileExpr) error {
+	switch e := expr.(type) {
+	case *SelectQuery:
+		e.IntoOutfile = intoOutfile
+	case *SetOperationExpr:
+		e.IntoOutfile = intoOutfile
+	default:
+		return fmt.Errorf("unexpected INTO OUTFILE, the statement is not a SELECT query")
+	}
+	return nil
+}
+
+// attachFormat sets the trailing FORMAT clause to the statement which outputs the data.
+func attachFormat(expr Expr, format *FormatExpr) error {
+	switch e := expr.(type) {
+	case *SelectQuery:
+		e.Format = format
+	case *SetOperationExpr:
+		e.Format = format
+	case *ShowExpr:
+		e.Format = format
+	case *DescribeExpr:
+		e.Format = format
+	case *ExistsExpr:
+		e.Format = format
+	case *WatchExpr:
+		e.Format = format
+	case *ShowCreateExpr:
+		e.Format = format
+	case *ShowGrantsExpr:
+		e.Format = format
+	case *CheckExpr:
+		e.Format = format
+	case *KillExpr:
+		e.Format = format
+	case *OptimizeExpr:
+		e.Format = format
+	case *SystemExpr:
+		e.Format = format
+	case *ExplainExpr:
+		return attachFormat(e.Statement, format)
+	case *InsertExpr:
+		// the FORMAT of INSERT ... SELECT is the output format of the SELECT
+		if e.SelectExpr == nil {
+			return fmt.Errorf("unexpected FORMAT after INSERT VALUES")
+		}
+		return attachFormat(e.SelectExpr, format)
+	default:
+		return fmt.Errorf("unexpected FORMAT, the statement does not output data")
+	}
+	return nil
+}
+
 func (p *Parser) ParseStatements() ([]Expr, error) {
 	var statements []Expr
 	for {
@@ -1067,6 +1526,10 @@ func (p *Parser) parseDeleteFrom(pos Pos) (*DeleteFromExpr, error) {
 	if err != nil {
 		return nil, err
 	}
+	inPartition, err := p.tryParseInPartitionExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
 
 	if err := p.consumeKeyword(KeywordWhere); err != nil {
 		return nil, err
@@ -1075,12 +1538,60 @@ func (p *Parser) parseDeleteFrom(pos Pos) (*DeleteFromExpr, error) {
 	if err != nil {
 		return nil, err
 	}
+	settings, err := p.tryParseSettingsExprList(p.Pos())
+	if err != nil {
+		return nil, err
+	}
 
 	return &DeleteFromExpr{
-		DeletePos: pos,
-		Table:     tableIdentifier,
-		OnCluster: onCluster,
-		WhereExpr: whereExpr,
+		DeletePos:   pos,
+		Table:       tableIdentifier,
+		OnCluster:   onCluster,
+		InPartition: inPartition,
+		WhereExpr:   whereExpr,
+		Settings:    settings,
+	}, nil
+}
+
+// Syntax: UPDATE tableIdentifier clusterClause? SET column = expr [, ...] (IN PARTITION partition)? WHERE filter
+func (p *Parser) parseUpdate(pos Pos) (*UpdateExpr, error) {
+	if err := p.consumeKeyword(KeywordUpdate); err != nil {
+		return nil, err
+	}
+	tableIdentifier, err := p.parseTableIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	onCluster, err := p.tryParseOnCluster(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordSet); err != nil {
+		return nil, err
+	}
+	assignments, err := p.parseUpdateAssignments(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	inPartition, err := p.tryParseInPartitionExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordWhere); err != nil {
+		return nil, err
+	}
+	whereExpr, err := p.parseExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateExpr{
+		UpdatePos:   pos,
+		Table:       tableIdentifier,
+		OnCluster:   onCluster,
+		Assignments: assignments,
+		InPartition: inPartition,
+		WhereExpr:   whereExpr,
 	}, nil
 }
 
@@ -1095,10 +1606,10 @@ func (p *Parser) parseColumnNamesExpr(pos Pos) (*ColumnNamesExpr, error) {
 		if err != nil {
 			return nil, err
 		}
+		columnNames = append(columnNames, *name)
 		if p.tryConsumeTokenKind(",") == nil {
 			break
 		}
-		columnNames = append(columnNames, *name)
 	}
 	rightParenPos := p.Pos()
 	if _, err := p.consumeTokenKind(")"); err != nil {
@@ -1146,6 +1657,8 @@ func (p *Parser) parseValuesExpr(pos Pos) (*ValuesExpr, error) {
 	}, nil
 }
 
+// Syntax: INSERT INTO [TABLE] table | FUNCTION func(...) [(col, ...)] [SETTINGS ...]
+// VALUES (...), ... | FORMAT format [data] | selectQuery
 func (p *Parser) parseInsertExpr(pos Pos) (*InsertExpr, error) {
 	if err := p.consumeKeyword(KeywordInsert); err != nil {
 		return nil, err
@@ -1153,67 +1666,108 @@ func (p *Parser) parseInsertExpr(pos Pos) (*InsertExpr, error) {
 	if err := p.consumeKeyword(KeywordInto); err != nil {
 		return nil, err
 	}
-	_ = p.tryConsumeKeyword(KeywordTable)
 
-	var table Expr
+	insertExpr := &InsertExpr{InsertPos: pos}
 	var err error
 	if p.tryConsumeKeyword(KeywordFunction) != nil {
-		table, err = p.parseFunctionExpr(p.Pos())
+		insertExpr.HasFunction = true
+		insertExpr.Table, err = p.parseTableFunctionCall(p.Pos())
 	} else {
-		table, err = p.parseTableIdentifier(p.Pos())
+		_ = p.tryConsumeKeyword(KeywordTable)
+		insertExpr.Table, err = p.parseTableIdentifier(p.Pos())
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	insertExpr := &InsertExpr{
-		InsertPos: pos,
-		Table:     table,
-	}
-
-	for i := 0; i < 1; i++ {
-		switch {
-		case p.matchKeyword(KeywordFormat):
-			insertExpr.Format, err = p.parseFormatExpr(p.Pos())
-		case p.matchKeyword(KeywordValues):
-			// consume VALUES keyword
-			_ = p.lexer.consumeToken()
-		case p.matchKeyword(KeywordSelect):
-			insertExpr.SelectExpr, err = p.parseSelectQuery(p.Pos())
+	if p.matchTokenKind("(") {
+		if peek, _ := p.lexer.peekToken(); peek == nil ||
+			peek.Kind != TokenKeyword || !strings.EqualFold(peek.String, KeywordSelect) {
+			insertExpr.ColumnNames, err = p.parseColumnNamesExpr(p.Pos())
 			if err != nil {
 				return nil, err
 			}
-			return insertExpr, nil
-		default:
-			if insertExpr.ColumnNames == nil {
-				// columns
-				insertExpr.ColumnNames, err = p.parseColumnNamesExpr(p.Pos())
-				// need another pass to parse keywords
-				i--
-			}
 		}
 	}
 
+	insertExpr.Settings, err = p.tryParseSettingsExprList(p.Pos())
 	if err != nil {
 		return nil, err
 	}
 
-	values := make([]*ValuesExpr, 0)
-	for !p.lexer.isEOF() {
-		value, err := p.parseValuesExpr(p.Pos())
-		if err != nil {
+	switch {
+	case p.matchKeyword(KeywordFormat):
+		if err := p.parseInsertFormat(insertExpr); err != nil {
 			return nil, err
 		}
-		values = append(values, value)
-		if p.tryConsumeTokenKind(",") == nil {
-			break
+	case p.matchKeyword(KeywordValues):
+		// consume VALUES keyword
+		_ = p.lexer.consumeToken()
+		for {
+			value, err := p.parseValuesExpr(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			insertExpr.Values = append(insertExpr.Values, value)
+			if p.tryConsumeTokenKind(",") == nil {
+				break
+			}
 		}
+	case p.matchKeyword(KeywordSelect), p.matchKeyword(KeywordWith), p.matchTokenKind("("):
+		insertExpr.SelectExpr, err = p.parseSelectQuery(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("expected VALUES, FORMAT or SELECT, but got %q", p.lastTokenKind())
 	}
-	insertExpr.Values = values
-
 	return insertExpr, nil
 }
 
+func (p *Parser) parseTableFunctionCall(_ Pos) (*TableFunctionExpr, error) {
+	name, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+	args, err := p.parseTableArgList(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	return &TableFunctionExpr{
+		Name: name,
+		Args: args,
+	}, nil
+}
+
+// parseInsertFormat parses the FORMAT clause of INSERT, everything after the format
+// name is the inline data which is kept as is rather than tokenized.
+func (p *Parser) parseInsertFormat(insertExpr *InsertExpr) error {
+	formatPos := p.Pos()
+	if err := p.consumeKeyword(KeywordFormat); err != nil {
+		return err
+	}
+	if !p.matchTokenKind(TokenIdent) {
+		return fmt.Errorf("expected <ident>, but got %q", p.lastTokenKind())
+	}
+	formatToken := p.last()
+	insertExpr.Format = &FormatExpr{
+		FormatPos: formatPos,
+		Format: &Ident{
+			NamePos:   formatToken.Pos,
+			NameEnd:   formatToken.End,
+			Name:      formatToken.String,
+			QuoteType: formatToken.QuoteType,
+		},
+	}
+	dataPos, data, err := p.lexer.consumeRawData()
+	if err != nil {
+		return err
+	}
+	insertExpr.DataPos = dataPos
+	insertExpr.Data = data
+	return nil
+}
+
 func (p *Parser) parseRenameStmt(pos Pos) (*RenameStmt, error) {
 	if err := p.consumeKeyword(KeywordRename); err != nil {
 		return nil, err
@@ -1264,6 +1818,53 @@ func (p *Parser) parseRenameStmt(pos Pos) (*RenameStmt, error) {
 	return renameStmt, nil
 }
 
+// Syntax: EXCHANGE {TABLES|DICTIONARIES} a AND b [ON CLUSTER cluster]
+func (p *Parser) parseExchangeStmt(pos Pos) (*ExchangeStmt, error) {
+	if err := p.consumeKeyword(KeywordExchange); err != nil {
+		return nil, err
+	}
+
+	var exchangeTarget string
+	switch {
+	case p.tryConsumeKeyword(KeywordTables) != nil:
+		exchangeTarget = KeywordTables
+	case p.tryConsumeKeyword(KeywordDictionaries) != nil:
+		exchangeTarget = KeywordDictionaries
+	default:
+		return nil, fmt.Errorf("expected TABLES or DICTIONARIES, but got %q", p.lastTokenKind())
+	}
+
+	left, err := p.parseTableIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordAnd); err != nil {
+		return nil, err
+	}
+	right, err := p.parseTableIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeStmt := &ExchangeStmt{
+		ExchangePos:    pos,
+		StatementEnd:   right.End(),
+		ExchangeTarget: exchangeTarget,
+		Left:           left,
+		Right:          right,
+	}
+
+	onClusterExpr, err := p.tryParseOnCluster(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if onClusterExpr != nil {
+		exchangeStmt.OnCluster = onClusterExpr
+		exchangeStmt.StatementEnd = onClusterExpr.End()
+	}
+	return exchangeStmt, nil
+}
+
 func (p *Parser) parseTargetPair(_ Pos) (*TargetPair, error) {
 	oldTable, err := p.parseTableIdentifier(p.Pos())
 	if err != nil {
@@ -1322,3 +1923,43 @@ func (p *Parser) parseCreateFunction(pos Pos) (*CreateFunction, error) {
 		Expr:         expr,
 	}, nil
 }
+
+// Syntax: CREATE INDEX [IF NOT EXISTS] name ON table expr TYPE type [GRANULARITY n]
+func (p *Parser) parseCreateIndex(pos Pos) (*CreateIndex, error) {
+	indexPos := p.Pos()
+	if err := p.consumeKeyword(KeywordIndex); err != nil {
+		return nil, err
+	}
+
+	ifNotExists, err := p.tryParseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := p.ParseNestedIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.consumeKeyword(KeywordOn); err != nil {
+		return nil, err
+	}
+
+	table, err := p.parseTableIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := p.parseTableIndexDefinition(indexPos, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateIndex{
+		CreatePos:    pos,
+		StatementEnd: index.End(),
+		IfNotExists:  ifNotExists,
+		Table:        table,
+		Index:        index,
+	}, nil
+}
@@ -110,3 +110,113 @@ func TestParser_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParser_QueryParams(t *testing.T) {
+	sql := `SELECT * FROM {tbl:Identifier} WHERE ts > {from:DateTime64(3)} AND id IN {ids:Array(UInt64)} LIMIT {limit:UInt32}`
+	parser := NewParser(sql)
+	_, err := parser.ParseStatements()
+	require.NoError(t, err)
+
+	params := parser.QueryParams()
+	require.Len(t, params, 4)
+	expected := [][2]string{
+		{"tbl", "Identifier"},
+		{"from", "DateTime64(3)"},
+		{"ids", "Array(UInt64)"},
+		{"limit", "UInt32"},
+	}
+	for i, param := range params {
+		require.Equal(t, expected[i][0], param.Name.Name)
+		require.Equal(t, expected[i][1], param.Type.String(0))
+	}
+
+	t.Run("table and database names", func(t *testing.T) {
+		parser := NewParser(`SELECT * FROM {db:Identifier}.{tbl:Identifier} JOIN db.{other:Identifier} USING id;
+INSERT INTO {target:Identifier} SELECT 1;
+ALTER TABLE {alter_tbl:Identifier} DELETE WHERE 1`)
+		_, err := parser.ParseStatements()
+		require.NoError(t, err)
+		var names []string
+		for _, param := range parser.QueryParams() {
+			names = append(names, param.Name.Name)
+		}
+		require.Equal(t, []string{"db", "tbl", "other", "target", "alter_tbl"}, names)
+	})
+}
+
+func TestParser_ResolveWindows(t *testing.T) {
+	sql := `SELECT row_number() OVER w1, sum(x) OVER (w2 ROWS BETWEEN 1 PRECEDING AND CURRENT ROW)
+FROM t WINDOW w1 AS (PARTITION BY a), w2 AS (w1 ORDER BY b)`
+	parser := NewParser(sql)
+	stmts, err := parser.ParseStatements()
+	require.NoError(t, err)
+	selectQuery := stmts[0].(*SelectQuery)
+	definitions := selectQuery.Window.Definitions
+	require.Len(t, definitions, 2)
+
+	rowNumber := selectQuery.SelectColumns.Items[0].(*WindowFunctionExpr)
+	require.Same(t, definitions[0], rowNumber.Window)
+	sum := selectQuery.SelectColumns.Items[1].(*WindowFunctionExpr)
+	require.Same(t, definitions[1], sum.OverExpr.(*WindowConditionExpr).Window)
+	require.Same(t, definitions[0], definitions[1].Condition.Window)
+
+	_, err = NewParser(`SELECT row_number() OVER w FROM t`).ParseStatements()
+	require.ErrorContains(t, err, `window "w" is not defined`)
+}
+
+func TestParser_InsertInlineData(t *testing.T) {
+	sql := "INSERT INTO t FORMAT CSV\n1,'a;b'\n2,\"c\"\n"
+	stmts, err := NewParser(sql).ParseStatements()
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+	insertExpr := stmts[0].(*InsertExpr)
+	require.Equal(t, "CSV", insertExpr.Format.Format.Name)
+	require.Equal(t, "1,'a;b'\n2,\"c\"\n", insertExpr.Data)
+	require.Equal(t, insertExpr.Data, sql[insertExpr.DataPos:insertExpr.End()])
+
+	stmts, err = NewParser("INSERT INTO t FORMAT CSV; SELECT 1").ParseStatements()
+	require.NoError(t, err)
+	require.Len(t, stmts, 2)
+	require.Empty(t, stmts[0].(*InsertExpr).Data)
+}
+
+func TestParser_BuildBackupExpr(t *testing.T) {
+	backupExpr := &BackupExpr{
+		Elements: []*BackupElement{
+			{Kind: KeywordTable, Table: &TableIdentifier{
+				Database: &Ident{Name: "db"},
+				Table:    &Ident{Name: "events"},
+			}},
+			{Kind: KeywordDatabase, Database: &Ident{Name: "logs"}, ExceptKind: KeywordTables, Except: []*TableIdentifier{
+				{Database: &Ident{Name: "logs"}, Table: &Ident{Name: "tmp"}},
+			}},
+		},
+		Destination: &TableFunctionExpr{
+			Name: &Ident{Name: "Disk"},
+			Args: &TableArgListExpr{Args: []Expr{
+				&StringLiteral{Literal: "backups", Value: "backups"},
+				&StringLiteral{Literal: "events.zip", Value: "events.zip"},
+			}},
+		},
+		Mode: KeywordAsync,
+	}
+	sql := backupExpr.String(0)
+	require.Equal(t, "BACKUP TABLE db.events, DATABASE logs EXCEPT TABLES logs.tmp TO Disk('backups','events.zip') ASYNC", sql)
+
+	stmts, err := NewParser(sql).ParseStatements()
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+	require.Equal(t, sql, stmts[0].String(0))
+}
+
+func TestParser_UnexpectedEOF(t *testing.T) {
+	for _, sql := range []string{
+		"CREATE NAMED COLLECTION nc AS key =",
+		"KILL QUERY ON CLUSTER",
+	} {
+		t.Run(sql, func(t *testing.T) {
+			_, err := NewParser(sql).ParseStatements()
+			require.ErrorContains(t, err, "<eof>")
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package parser
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 func (p *Parser) parseCreateMaterializedView(pos Pos) (*CreateMaterializedView, error) {
 	if err := p.consumeKeyword(KeywordMaterialized); err != nil {
@@ -32,6 +35,19 @@ func (p *Parser) parseCreateMaterializedView(pos Pos) (*CreateMaterializedView,
 	}
 	createMaterializedView.OnCluster = onCluster
 
+	refresh, err := p.tryParseRefreshExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if refresh != nil {
+		createMaterializedView.Refresh = refresh
+		createMaterializedView.StatementEnd = refresh.End()
+		if appendToken := p.tryConsumeKeyword(KeywordAppend); appendToken != nil {
+			createMaterializedView.Append = true
+			createMaterializedView.StatementEnd = appendToken.End
+		}
+	}
+
 	switch {
 	case p.matchKeyword(KeywordTo):
 		destinationExpr, err := p.parseDestinationExpr(p.Pos())
@@ -46,6 +62,7 @@ func (p *Parser) parseCreateMaterializedView(pos Pos) (*CreateMaterializedView,
 				return nil, err
 			}
 			createMaterializedView.Destination.TableSchema = tableSchema
+			createMaterializedView.StatementEnd = tableSchema.End()
 		}
 	case p.matchKeyword(KeywordEngine):
 		engineExpr, err := p.parseEngineExpr(p.Pos())
@@ -54,12 +71,19 @@ func (p *Parser) parseCreateMaterializedView(pos Pos) (*CreateMaterializedView,
 		}
 		createMaterializedView.Engine = engineExpr
 		createMaterializedView.StatementEnd = engineExpr.End()
+	case refresh == nil:
+		// TO or ENGINE is required unless the view is refreshable
+		return nil, fmt.Errorf("unexpected token: %q, expected TO or ENGINE", p.lastTokenKind())
+	}
+	if emptyToken := p.tryConsumeKeyword(KeywordEmpty); emptyToken != nil {
+		createMaterializedView.Empty = true
+		createMaterializedView.StatementEnd = emptyToken.End
+	}
+	if createMaterializedView.Engine != nil {
 		if populate := p.tryConsumeKeyword(KeywordPopulate); populate != nil {
 			createMaterializedView.Populate = true
 			createMaterializedView.StatementEnd = populate.End
 		}
-	default:
-		return nil, fmt.Errorf("unexpected token: %q, expected TO or ENGINE", p.lastTokenKind())
 	}
 	if p.matchKeyword(KeywordAs) {
 		subQuery, err := p.parseSubQuery(p.Pos())
@@ -72,6 +96,97 @@ func (p *Parser) parseCreateMaterializedView(pos Pos) (*CreateMaterializedView,
 	return createMaterializedView, nil
 }
 
+// Syntax: REFRESH EVERY|AFTER interval [OFFSET interval] [RANDOMIZE FOR interval]
+// [DEPENDS ON table [, ...]] [SETTINGS name = value [, ...]]
+func (p *Parser) tryParseRefreshExpr(pos Pos) (*RefreshExpr, error) {
+	if p.tryConsumeKeyword(KeywordRefresh) == nil {
+		return nil, nil // nolint
+	}
+
+	refresh := &RefreshExpr{RefreshPos: pos}
+	switch {
+	case p.tryConsumeKeyword(KeywordEvery) != nil:
+		refresh.Frequency = KeywordEvery
+	case p.tryConsumeKeyword(KeywordAfter) != nil:
+		refresh.Frequency = KeywordAfter
+	default:
+		return nil, fmt.Errorf("expected keyword <EVERY> or <AFTER>, but got %q", p.lastTokenKind())
+	}
+	interval, err := p.parseRefreshInterval(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	refresh.Interval = interval
+	refresh.RefreshEnd = interval.End()
+
+	// OFFSET is only meaningful for the periodic refresh
+	if refresh.Frequency == KeywordEvery && p.tryConsumeKeyword(KeywordOffset) != nil {
+		refresh.Offset, err = p.parseRefreshInterval(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		refresh.RefreshEnd = refresh.Offset.End()
+	}
+
+	if p.tryConsumeKeyword(KeywordRandomize) != nil {
+		if err := p.consumeKeyword(KeywordFor); err != nil {
+			return nil, err
+		}
+		refresh.RandomizeFor, err = p.parseRefreshInterval(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		refresh.RefreshEnd = refresh.RandomizeFor.End()
+	}
+
+	if p.tryConsumeKeyword(KeywordDepends) != nil {
+		if err := p.consumeKeyword(KeywordOn); err != nil {
+			return nil, err
+		}
+		for {
+			table, err := p.parseTableIdentifier(p.Pos())
+			if err != nil {
+				return nil, err
+			}
+			refresh.DependsOn = append(refresh.DependsOn, table)
+			refresh.RefreshEnd = table.End()
+			if p.tryConsumeTokenKind(",") == nil {
+				break
+			}
+		}
+	}
+
+	settings, err := p.tryParseSettingsExprList(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if settings != nil {
+		refresh.Settings = settings
+		refresh.RefreshEnd = settings.End()
+	}
+	return refresh, nil
+}
+
+// parseRefreshInterval parses the interval without the INTERVAL keyword, e.g. 1 HOUR.
+func (p *Parser) parseRefreshInterval(pos Pos) (*IntervalExpr, error) {
+	number, err := p.parseNumber(pos)
+	if err != nil {
+		return nil, err
+	}
+	unit, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+	if !intervalType.Contains(strings.ToUpper(unit.Name)) {
+		return nil, fmt.Errorf("unknown interval type: <%q>", unit.Name)
+	}
+	return &IntervalExpr{
+		IntervalPos: pos,
+		Expr:        number,
+		Unit:        unit,
+	}, nil
+}
+
 // (ATTACH | CREATE) (OR REPLACE)? VIEW (IF NOT EXISTS)? tableIdentifier uuidClause? clusterClause? tableSchemaClause? subqueryClause
 func (p *Parser) parseCreateView(pos Pos) (*CreateView, error) {
 	if err := p.consumeKeyword(KeywordView); err != nil {
@@ -212,3 +327,134 @@ func (p *Parser) tryParseWithTimeout(pos Pos) (*WithTimeoutExpr, error) {
 
 	return withTimeoutExpr, nil
 }
+
+// Syntax: CREATE WINDOW VIEW [IF NOT EXISTS] name [ON CLUSTER cluster] [TO table]
+// [INNER ENGINE engine] [ENGINE engine] [WATERMARK = strategy] [ALLOWED_LATENESS = interval]
+// [POPULATE] AS SELECT ...
+func (p *Parser) parseCreateWindowView(pos Pos) (*CreateWindowView, error) {
+	if err := p.consumeKeyword(KeywordWindow); err != nil {
+		return nil, err
+	}
+	if err := p.consumeKeyword(KeywordView); err != nil {
+		return nil, err
+	}
+
+	createWindowView := &CreateWindowView{CreatePos: pos}
+	var err error
+	createWindowView.IfNotExists, err = p.tryParseIfNotExists()
+	if err != nil {
+		return nil, err
+	}
+
+	tableIdentifier, err := p.parseTableIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	createWindowView.Name = tableIdentifier
+	createWindowView.StatementEnd = tableIdentifier.End()
+
+	onCluster, err := p.tryParseOnCluster(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	createWindowView.OnCluster = onCluster
+
+	if p.matchKeyword(KeywordTo) {
+		destinationExpr, err := p.parseDestinationExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		createWindowView.Destination = destinationExpr
+		createWindowView.StatementEnd = destinationExpr.End()
+	}
+
+	if p.tryConsumeKeyword(KeywordInner) != nil {
+		innerEngine, err := p.parseEngineExpr(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		createWindowView.InnerEngine = innerEngine
+		createWindowView.StatementEnd = innerEngine.End()
+	}
+
+	engine, err := p.tryParseEngineExpr(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	if engine != nil {
+		createWindowView.Engine = engine
+		createWindowView.StatementEnd = engine.End()
+	}
+
+	if p.tryConsumeKeyword(KeywordWatermark) != nil {
+		if _, err := p.consumeTokenKind("="); err != nil {
+			return nil, err
+		}
+		var watermark Expr
+		if p.matchKeyword(KeywordInterval) {
+			watermark, err = p.parseColumnExprInterval(p.Pos())
+		} else {
+			watermark, err = p.parseIdent()
+		}
+		if err != nil {
+			return nil, err
+		}
+		createWindowView.Watermark = watermark
+		createWindowView.StatementEnd = watermark.End()
+	}
+
+	if p.tryConsumeKeyword(KeywordAllowedLateness) != nil {
+		if _, err := p.consumeTokenKind("="); err != nil {
+			return nil, err
+		}
+		allowedLateness, err := p.parseColumnExprInterval(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		createWindowView.AllowedLateness = allowedLateness
+		createWindowView.StatementEnd = allowedLateness.End()
+	}
+
+	if populate := p.tryConsumeKeyword(KeywordPopulate); populate != nil {
+		createWindowView.Populate = true
+		createWindowView.StatementEnd = populate.End
+	}
+
+	subQuery, err := p.parseSubQuery(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	createWindowView.SubQuery = subQuery
+	createWindowView.StatementEnd = subQuery.End()
+	return createWindowView, nil
+}
+
+// Syntax: WATCH [db.]live_view [EVENTS] [LIMIT n] [FORMAT format]
+func (p *Parser) parseWatchExpr(pos Pos) (*WatchExpr, error) {
+	if err := p.consumeKeyword(KeywordWatch); err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseTableIdentifier(p.Pos())
+	if err != nil {
+		return nil, err
+	}
+	watchExpr := &WatchExpr{
+		WatchPos:     pos,
+		StatementEnd: name.End(),
+		Name:         name,
+	}
+	if events := p.tryConsumeKeyword(KeywordEvents); events != nil {
+		watchExpr.Events = true
+		watchExpr.StatementEnd = events.End
+	}
+	if p.tryConsumeKeyword(KeywordLimit) != nil {
+		limit, err := p.parseNumber(p.Pos())
+		if err != nil {
+			return nil, err
+		}
+		watchExpr.Limit = limit
+		watchExpr.StatementEnd = limit.End()
+	}
+	return watchExpr, nil
+}
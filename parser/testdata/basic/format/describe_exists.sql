@@ -0,0 +1,26 @@
+-- Origin SQL:
+DESCRIBE TABLE db.events;
+DESC events;
+DESCRIBE numbers(10);
+DESCRIBE (SELECT 1 AS a);
+DESCRIBE TABLE db.events SETTINGS describe_include_subcolumns = 1;
+DESC events SETTINGS describe_include_subcolumns = 1, describe_compact_output = 1 FORMAT JSON;
+EXISTS TABLE db.events;
+EXISTS TEMPORARY TABLE tmp;
+EXISTS DICTIONARY dict;
+EXISTS events;
+
+
+-- Format SQL:
+DESCRIBE TABLE db.events;
+DESCRIBE events;
+DESCRIBE numbers(10);
+DESCRIBE (
+  SELECT 
+    1 AS a);
+DESCRIBE TABLE db.events SETTINGS describe_include_subcolumns=1;
+DESCRIBE events SETTINGS describe_include_subcolumns=1, describe_compact_output=1 FORMAT JSON;
+EXISTS TABLE db.events;
+EXISTS TEMPORARY TABLE tmp;
+EXISTS DICTIONARY dict;
+EXISTS events;
@@ -0,0 +1,28 @@
+-- Origin SQL:
+KILL QUERY WHERE query_id = '2-857d-4a57-9ee0-327da5d60a90';
+KILL QUERY ON CLUSTER 'my_cluster' WHERE user = 'username' SYNC;
+KILL MUTATION WHERE database = 'default' AND table = 'table' TEST;
+KILL MUTATION WHERE database = 'default' AND table = 'table' AND mutation_id = 'mutation_3.txt' ASYNC;
+KILL QUERY WHERE query_id = '1' FORMAT JSON;
+
+
+-- Format SQL:
+KILL QUERY
+WHERE
+  query_id = '2-857d-4a57-9ee0-327da5d60a90';
+KILL QUERY ON CLUSTER 'my_cluster'
+WHERE
+  user = 'username'
+SYNC;
+KILL MUTATION
+WHERE
+  database = 'default' AND table = 'table'
+TEST;
+KILL MUTATION
+WHERE
+  database = 'default' AND table = 'table' AND mutation_id = 'mutation_3.txt'
+ASYNC;
+KILL QUERY
+WHERE
+  query_id = '1'
+FORMAT JSON;
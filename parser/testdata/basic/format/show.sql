@@ -0,0 +1,42 @@
+-- Origin SQL:
+SHOW TABLES;
+SHOW FULL TEMPORARY TABLES FROM system LIKE '%user%' LIMIT 10;
+SHOW TABLES IN db NOT ILIKE 'tmp_%';
+SHOW TABLES FROM system WHERE name LIKE '%log%' LIMIT 5;
+SHOW DATABASES LIKE 'db%';
+SHOW DICTIONARIES FROM db;
+SHOW COLUMNS FROM events FROM db LIKE 'id%';
+SHOW PROCESSLIST;
+SHOW CREATE TABLE db.events;
+SHOW CREATE TEMPORARY TABLE tmp;
+SHOW CREATE DICTIONARY dict;
+SHOW CREATE events;
+SHOW GRANTS;
+SHOW GRANTS FOR john, mary WITH IMPLICIT FINAL;
+SHOW CREATE TABLE t FORMAT TSVRaw;
+SHOW GRANTS FOR u1 FORMAT JSON;
+
+
+-- Format SQL:
+SHOW TABLES;
+SHOW FULL TEMPORARY TABLES FROM system LIKE '%user%'
+LIMIT 10;
+SHOW TABLES FROM db NOT ILIKE 'tmp_%';
+SHOW TABLES FROM system
+WHERE
+  name LIKE '%log%'
+LIMIT 5;
+SHOW DATABASES LIKE 'db%';
+SHOW DICTIONARIES FROM db;
+SHOW COLUMNS FROM events FROM db LIKE 'id%';
+SHOW PROCESSLIST;
+SHOW CREATE TABLE db.events;
+SHOW CREATE TEMPORARY TABLE tmp;
+SHOW CREATE DICTIONARY dict;
+SHOW CREATE events;
+SHOW GRANTS;
+SHOW GRANTS FOR john, mary WITH IMPLICIT FINAL;
+SHOW CREATE TABLE t
+FORMAT TSVRaw;
+SHOW GRANTS FOR u1
+FORMAT JSON;
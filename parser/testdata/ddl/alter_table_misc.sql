@@ -0,0 +1,29 @@
+ALTER TABLE events MOVE PARTITION 202401 TO DISK 'cold';
+ALTER TABLE events MOVE PARTITION ID '202401' TO VOLUME 'slow';
+ALTER TABLE events MOVE PARTITION 202401 TO TABLE db.events_archive;
+ALTER TABLE events FETCH PARTITION 202401 FROM '/clickhouse/tables/01-01/events';
+ALTER TABLE events MOVE PART 'all_1_1_0' TO DISK 'cold';
+ALTER TABLE events MOVE PART '202401_1_1_0' TO VOLUME 'slow';
+ALTER TABLE events FETCH PART '202401_1_1_0' FROM '/clickhouse/tables/01-01/events';
+ALTER TABLE events MATERIALIZE INDEX IF EXISTS idx_user IN PARTITION 202401;
+ALTER TABLE events MATERIALIZE COLUMN total;
+ALTER TABLE events MATERIALIZE TTL;
+ALTER TABLE events MATERIALIZE TTL IN PARTITION 202401;
+ALTER TABLE events ADD PROJECTION IF NOT EXISTS p_user (SELECT user_id, count() GROUP BY user_id ORDER BY user_id);
+ALTER TABLE events ADD PROJECTION p_sorted (SELECT * ORDER BY created_at);
+ALTER TABLE events ADD PROJECTION p_amount (SELECT * ORDER BY amount) WITH SETTINGS (index_granularity = 1024);
+ALTER TABLE events DROP PROJECTION IF EXISTS p_user;
+ALTER TABLE events MATERIALIZE PROJECTION p_user IN PARTITION 202401;
+ALTER TABLE events CLEAR PROJECTION p_user IN PARTITION 202401;
+ALTER TABLE events ADD CONSTRAINT IF NOT EXISTS c_positive CHECK amount > 0;
+ALTER TABLE events DROP CONSTRAINT c_positive;
+ALTER TABLE events MODIFY ORDER BY (user_id, created_at);
+ALTER TABLE events MODIFY SETTING max_part_loading_threads = 8, max_parts_in_total = 50000;
+ALTER TABLE events RESET SETTING max_part_loading_threads, max_parts_in_total;
+ALTER TABLE events MODIFY SETTING max_part_loading_threads = 8, ttl_only_drop_parts = 1, MODIFY COLUMN total UInt64;
+ALTER TABLE events RESET SETTING max_part_loading_threads, ttl_only_drop_parts, MODIFY COMMENT 'events';
+ALTER TABLE events MODIFY COMMENT 'raw events';
+ALTER TABLE events_mv MODIFY QUERY SELECT user_id, count() AS cnt FROM events GROUP BY user_id;
+ALTER TABLE events COMMENT COLUMN IF EXISTS user_id 'the user id';
+ALTER TABLE events UNFREEZE PARTITION 202401 WITH NAME 'backup_1';
+ALTER TABLE events UNFREEZE WITH NAME 'backup_1';
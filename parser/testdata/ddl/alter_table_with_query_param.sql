@@ -0,0 +1,2 @@
+ALTER TABLE {tbl:Identifier} DELETE WHERE id = {id:UInt64};
+ALTER TABLE {db:Identifier}.{tbl:Identifier} ADD COLUMN c String;
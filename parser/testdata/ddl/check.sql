@@ -1,2 +1,3 @@
 CHECK TABLE test_table;
 CHECK TABLE test_table PARTITION 'col';
+CHECK TABLE t FORMAT JSON;
@@ -0,0 +1,68 @@
+CREATE DICTIONARY IF NOT EXISTS test.dict_hashed ON CLUSTER default_cluster
+(
+    id UInt64,
+    parent_id UInt64 DEFAULT 0 HIERARCHICAL,
+    name String DEFAULT '' INJECTIVE,
+    object_id UInt64 IS_OBJECT_ID,
+    full_name String EXPRESSION concat(name, '_suffix')
+)
+PRIMARY KEY id
+SOURCE(CLICKHOUSE(HOST 'localhost' PORT 9000 USER 'default' PASSWORD '' DB 'test' TABLE 'source_table'))
+LAYOUT(HASHED())
+LIFETIME(MIN 0 MAX 300)
+SETTINGS(format_csv_allow_single_quotes = 0)
+COMMENT 'hashed dictionary';
+
+CREATE DICTIONARY test.dict_range
+(
+    key UInt64,
+    start_date Date,
+    end_date Date,
+    value Nullable(Float64)
+)
+PRIMARY KEY key
+SOURCE(CLICKHOUSE(TABLE 'range_source'))
+LIFETIME(300)
+LAYOUT(RANGE_HASHED(range_lookup_strategy 'max'))
+RANGE(MIN start_date MAX end_date);
+
+CREATE DICTIONARY test.dict_complex_key
+(
+    k1 String,
+    k2 UInt32,
+    value String
+)
+PRIMARY KEY k1, k2
+SOURCE(MYSQL(port 3306 user 'root' password '' replica 'host1' db 'db' table 'complex'))
+LAYOUT(COMPLEX_KEY_HASHED(SIZE_IN_CELLS 1000000))
+LIFETIME(MAX 600 MIN 100);
+
+CREATE DICTIONARY test.dict_http
+(
+    id UInt64,
+    value String
+)
+PRIMARY KEY id
+SOURCE(HTTP(url 'http://localhost/dict.tsv' format 'TabSeparated' headers(header(name 'API-KEY' value 'secret') header(name 'X-Trace' value '1'))))
+LAYOUT(FLAT())
+LIFETIME(300);
+
+CREATE DICTIONARY test.dict_mysql_replicas
+(
+    id UInt64,
+    value String
+)
+PRIMARY KEY id
+SOURCE(MYSQL(port 3306 user 'root' password '' replica(host 'host1' priority 1) replica(host 'host2' priority 2) db 'db' table 'src'))
+LAYOUT(HASHED())
+LIFETIME(MIN 0 MAX 300);
+
+CREATE OR REPLACE DICTIONARY test.dict_replaced
+(
+    id UInt64,
+    value String
+)
+PRIMARY KEY id
+SOURCE(CLICKHOUSE(TABLE 'src'))
+LAYOUT(FLAT())
+LIFETIME(300);
@@ -0,0 +1,4 @@
+CREATE INDEX idx_value ON test.t (value) TYPE minmax GRANULARITY 1;
+CREATE INDEX IF NOT EXISTS idx_name ON t (lower(name), id) TYPE bloom_filter(0.01);
+DROP INDEX idx_value ON test.t;
+DROP INDEX IF EXISTS idx_name ON t;
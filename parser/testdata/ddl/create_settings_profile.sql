@@ -0,0 +1,11 @@
+CREATE SETTINGS PROFILE IF NOT EXISTS max_memory_usage_profile SETTINGS max_memory_usage = 100000001 MIN 90000000 MAX 110000000 TO robin;
+CREATE SETTINGS PROFILE OR REPLACE p1 ON CLUSTER default_cluster, p2 IN local_directory SETTINGS INHERIT 'default', readonly = 1 CONST TO ALL EXCEPT admin;
+CREATE PROFILE p3 SETTINGS max_threads = 4 WRITABLE;
+ALTER SETTINGS PROFILE IF EXISTS p1 RENAME TO p1_new SETTINGS max_threads = 8 TO NONE;
+ALTER PROFILE p3 SETTINGS NONE;
+DROP SETTINGS PROFILE IF EXISTS p1, p2 ON CLUSTER default_cluster;
+DROP PROFILE p3;
+CREATE SETTINGS PROFILE p4 SETTINGS max_memory_usage = 100 MIN 1 MAX 1000 READONLY TO r;
+CREATE SETTINGS PROFILE p5 SETTINGS max_memory_usage = 100 MIN 1 MAX 1000 READONLY;
+ALTER SETTINGS PROFILE p5 SETTINGS max_threads MAX 8;
+CREATE SETTINGS PROFILE p6 SETTINGS max_memory_usage = 100 MIN 1 MAX 1000 CHANGEABLE_IN_READONLY, max_threads CHANGEABLE_IN_READONLY TO r;
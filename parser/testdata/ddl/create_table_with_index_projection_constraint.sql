@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS db.events
+(
+    id UInt64,
+    name String,
+    ts DateTime,
+    INDEX idx_name lower(name) TYPE bloom_filter(0.01) GRANULARITY 4,
+    INDEX idx_ts ts TYPE minmax,
+    PROJECTION p_by_name (SELECT name, count() GROUP BY name),
+    CONSTRAINT c_id CHECK id > 0,
+    CONSTRAINT c_ts ASSUME ts > '2020-01-01'
+)
+ENGINE = MergeTree
+ORDER BY id
+COMMENT 'events table';
+CREATE TABLE t_s3 AS s3('https://bucket.s3.amazonaws.com/data.csv', 'CSV');
+CREATE TABLE t_empty ENGINE = MergeTree ORDER BY id EMPTY AS SELECT id, name FROM db.events;
+CREATE TABLE t_clone CLONE AS db.events;
+CREATE TABLE db.events_by_user
+(
+    user_id UInt64,
+    amount Float64,
+    PROJECTION p_by_user (SELECT user_id, sum(amount) GROUP BY user_id) WITH SETTINGS (index_granularity = 4096, index_granularity_bytes = 0)
+)
+ENGINE = MergeTree
+ORDER BY user_id;
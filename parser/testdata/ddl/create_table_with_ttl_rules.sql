@@ -0,0 +1,17 @@
+CREATE TABLE test.events_ttl
+(
+    ts DateTime,
+    kind String,
+    k UInt64,
+    v UInt64,
+    payload String TTL ts + INTERVAL 1 DAY COMMENT 'raw payload',
+    extra String CODEC(ZSTD(3)) TTL ts + INTERVAL 7 DAY
+) ENGINE = MergeTree
+ORDER BY (k, ts)
+TTL ts + INTERVAL 30 DAY TO VOLUME 'cold',
+    ts + INTERVAL 60 DAY TO DISK 'archive',
+    ts + INTERVAL 90 DAY RECOMPRESS CODEC(ZSTD(12)),
+    ts + INTERVAL 1 YEAR DELETE WHERE kind = 'debug',
+    ts + INTERVAL 2 YEAR WHERE kind = 'info',
+    ts + INTERVAL 7 DAY GROUP BY k SET v = sum(v), kind = any(kind),
+    ts + INTERVAL 3 YEAR;
@@ -1 +1,3 @@
 DROP DATABASE IF EXISTS datbase_name;
+DROP DATABASE d ON CLUSTER 'default_cluster' SYNC;
+DROP DATABASE IF EXISTS d SYNC;
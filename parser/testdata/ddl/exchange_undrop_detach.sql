@@ -0,0 +1,19 @@
+-- exchange
+EXCHANGE TABLES test.t_blue AND test.t_green;
+EXCHANGE TABLES t_blue AND t_green ON CLUSTER 'default_cluster';
+EXCHANGE DICTIONARIES dict_a AND dict_b;
+-- undrop
+UNDROP TABLE test.t;
+UNDROP TABLE test.t UUID '61f0c404-5cb3-11e7-907b-a6006ad3dba0' ON CLUSTER 'default_cluster';
+-- detach
+DETACH TABLE test.t PERMANENTLY;
+DETACH VIEW IF EXISTS test.v ON CLUSTER 'default_cluster' PERMANENTLY SYNC;
+DETACH DICTIONARY test.dict;
+DETACH DATABASE db PERMANENTLY;
+DETACH DATABASE IF EXISTS db ON CLUSTER 'default_cluster' PERMANENTLY SYNC;
+-- drop
+DROP TABLE IF EMPTY test.t;
+DROP TABLE IF EXISTS IF EMPTY test.a, test.b ON CLUSTER 'default_cluster' SYNC;
+DROP TABLE IF EXISTS test.a, test.b, c SYNC;
+DROP TEMPORARY TABLE t1, t2;
+DROP DICTIONARY test.dict SYNC;
@@ -0,0 +1,10 @@
+-- Origin SQL:
+ALTER TABLE test.events_ttl MODIFY TTL ts + INTERVAL 30 DAY TO VOLUME 'cold', ts + INTERVAL 1 YEAR DELETE WHERE kind = 'debug', ts + INTERVAL 7 DAY GROUP BY k SET v = sum(v);
+ALTER TABLE test.events_ttl MODIFY COLUMN payload String TTL ts + INTERVAL 1 DAY;
+
+
+-- Format SQL:
+ALTER TABLE test.events_ttl
+MODIFY TTL ts + INTERVAL 30 DAY TO VOLUME 'cold', ts + INTERVAL 1 YEAR DELETE WHERE kind = 'debug', ts + INTERVAL 7 DAY GROUP BY k SET v = sum(v);
+ALTER TABLE test.events_ttl
+MODIFY COLUMN payload String TTL ts + INTERVAL 1 DAY;
@@ -0,0 +1,10 @@
+-- Origin SQL:
+ALTER TABLE {tbl:Identifier} DELETE WHERE id = {id:UInt64};
+ALTER TABLE {db:Identifier}.{tbl:Identifier} ADD COLUMN c String;
+
+
+-- Format SQL:
+ALTER TABLE {tbl:Identifier}
+DELETE WHERE id = {id:UInt64};
+ALTER TABLE {db:Identifier}.{tbl:Identifier}
+ADD COLUMN c String;
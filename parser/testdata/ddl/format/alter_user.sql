@@ -0,0 +1,22 @@
+-- Origin SQL:
+ALTER USER john RENAME TO john_new;
+ALTER USER IF EXISTS u1 ON CLUSTER default_cluster, u2 IDENTIFIED WITH plaintext_password BY 'new_password';
+ALTER USER u1 ADD HOST IP '10.0.0.0/8' DROP HOST LOCAL;
+ALTER USER u1 NOT IDENTIFIED DEFAULT ROLE NONE;
+ALTER USER u1 DEFAULT ROLE r1 GRANTEES NONE SETTINGS max_threads = 8;
+ALTER USER u1 SETTINGS max_memory_usage READONLY;
+ALTER USER u1 IDENTIFIED WITH plaintext_password BY '1', ssl_certificate CN 'mysite.com:user';
+ALTER USER u1 ADD IDENTIFIED WITH bcrypt_password BY '2', sha256_password BY '3' ADD HOST LOCAL;
+ALTER USER u1 RESET AUTHENTICATION METHODS TO NEW;
+
+
+-- Format SQL:
+ALTER USER john RENAME TO john_new;
+ALTER USER IF EXISTS u1 ON CLUSTER default_cluster, u2 IDENTIFIED WITH plaintext_password BY 'new_password';
+ALTER USER u1 ADD HOST IP '10.0.0.0/8' DROP HOST LOCAL;
+ALTER USER u1 NOT IDENTIFIED DEFAULT ROLE NONE;
+ALTER USER u1 DEFAULT ROLE r1 GRANTEES NONE SETTINGS max_threads 8;
+ALTER USER u1 SETTINGS max_memory_usage READONLY;
+ALTER USER u1 IDENTIFIED WITH plaintext_password BY '1', ssl_certificate CN 'mysite.com:user';
+ALTER USER u1 ADD IDENTIFIED WITH bcrypt_password BY '2', sha256_password BY '3' ADD HOST LOCAL;
+ALTER USER u1 RESET AUTHENTICATION METHODS TO NEW;
@@ -1,10 +1,12 @@
 -- Origin SQL:
 CHECK TABLE test_table;
 CHECK TABLE test_table PARTITION 'col';
+CHECK TABLE t FORMAT JSON;
 
 
 -- Format SQL:
-CHECK TABLE test_table
-;
+CHECK TABLE test_table;
 CHECK TABLE test_table
 PARTITION 'col';
+CHECK TABLE t
+FORMAT JSON;
@@ -0,0 +1,18 @@
+-- Origin SQL:
+CREATE QUOTA IF NOT EXISTS qA FOR INTERVAL 15 month MAX queries = 123 TO CURRENT_USER;
+CREATE QUOTA qB ON CLUSTER default_cluster KEYED BY client_key, user_name FOR INTERVAL 30 minute MAX execution_time = 0.5, FOR INTERVAL 5 quarter MAX queries = 321, errors = 10 TO default;
+CREATE QUOTA OR REPLACE qC NOT KEYED FOR RANDOMIZED INTERVAL 1 hour NO LIMITS, FOR INTERVAL 1 day TRACKING ONLY TO ALL EXCEPT r1;
+ALTER QUOTA IF EXISTS qA RENAME TO qA_new KEYED BY ip_address FOR INTERVAL 1 day MAX queries = 100, MAX result_rows = 1000 TO r1, r2;
+DROP QUOTA IF EXISTS qA, qB ON CLUSTER default_cluster;
+CREATE QUOTA qD FOR INTERVAL 1 hour MAX queries = 10 FOR INTERVAL 1 day MAX queries = 100, errors = 5 TO r1;
+ALTER QUOTA qD FOR INTERVAL 1 hour MAX queries = 20 FOR RANDOMIZED INTERVAL 1 week NO LIMITS;
+
+
+-- Format SQL:
+CREATE QUOTA IF NOT EXISTS qA FOR INTERVAL 15 month MAX queries = 123 TO CURRENT_USER;
+CREATE QUOTA qB ON CLUSTER default_cluster KEYED BY client_key, user_name FOR INTERVAL 30 minute MAX execution_time = 0.5, FOR INTERVAL 5 quarter MAX queries = 321, errors = 10 TO default;
+CREATE QUOTA OR REPLACE qC NOT KEYED FOR RANDOMIZED INTERVAL 1 hour NO LIMITS, FOR INTERVAL 1 day TRACKING ONLY TO ALL EXCEPT r1;
+ALTER QUOTA IF EXISTS qA RENAME TO qA_new KEYED BY ip_address FOR INTERVAL 1 day MAX queries = 100, result_rows = 1000 TO r1, r2;
+DROP QUOTA IF EXISTS qA, qB ON CLUSTER default_cluster;
+CREATE QUOTA qD FOR INTERVAL 1 hour MAX queries = 10, FOR INTERVAL 1 day MAX queries = 100, errors = 5 TO r1;
+ALTER QUOTA qD FOR INTERVAL 1 hour MAX queries = 20, FOR RANDOMIZED INTERVAL 1 week NO LIMITS;
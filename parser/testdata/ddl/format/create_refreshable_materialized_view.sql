@@ -0,0 +1,47 @@
+-- Origin SQL:
+CREATE MATERIALIZED VIEW IF NOT EXISTS db.rollup_hourly
+REFRESH EVERY 1 HOUR OFFSET 5 MINUTE RANDOMIZE FOR 1 MINUTE DEPENDS ON db.other, db.another SETTINGS refresh_retries = 3
+APPEND TO db.rollup_target (hour DateTime, cnt UInt64) EMPTY
+AS SELECT toStartOfHour(ts) AS hour, count() AS cnt FROM db.events GROUP BY hour;
+CREATE MATERIALIZED VIEW db.latest REFRESH AFTER 30 SECOND ENGINE = Memory AS SELECT * FROM db.events ORDER BY ts DESC LIMIT 10;
+CREATE MATERIALIZED VIEW db.mv TO db.target (id, name) AS SELECT id, name FROM db.src;
+
+
+-- Format SQL:
+CREATE MATERIALIZED VIEW IF NOT EXISTS db.rollup_hourly
+REFRESH EVERY 1 HOUR OFFSET 5 MINUTE RANDOMIZE FOR 1 MINUTE DEPENDS ON db.other, db.another SETTINGS refresh_retries=3
+APPEND
+TO db.rollup_target
+(
+    hour DateTime,
+    cnt UInt64
+) EMPTY AS (
+  SELECT 
+    toStartOfHour(ts) AS hour,
+    count() AS cnt
+  FROM
+    db.events
+  GROUP BY hour
+);
+CREATE MATERIALIZED VIEW db.latest
+REFRESH AFTER 30 SECOND
+ENGINE = Memory AS (
+  SELECT 
+    *
+  FROM
+    db.events
+  ORDER BY ts DESC
+  LIMIT 10
+);
+CREATE MATERIALIZED VIEW db.mv
+TO db.target
+(
+    id,
+    name
+) AS (
+  SELECT 
+    id,
+    name
+  FROM
+    db.src
+);
@@ -0,0 +1,18 @@
+-- Origin SQL:
+CREATE ROW POLICY IF NOT EXISTS filter ON mydb.mytable FOR SELECT USING a < 1000 TO accountant, john@'localhost';
+CREATE ROW POLICY OR REPLACE p1 ON CLUSTER default_cluster ON mydb.table1 USING b = 1 AS RESTRICTIVE TO ALL EXCEPT mira;
+CREATE POLICY p2, p3 ON mydb.* IN local_directory USING 1 AS PERMISSIVE TO NONE;
+ALTER ROW POLICY IF EXISTS filter ON mydb.mytable RENAME TO new_filter USING NONE TO ALL;
+ALTER POLICY p1 ON mydb.table1 FOR SELECT USING c > 10 AND d IN (1, 2) AS PERMISSIVE;
+DROP ROW POLICY IF EXISTS p1, p2 ON mydb.table1, p3 ON mydb.table2 ON CLUSTER default_cluster;
+DROP POLICY filter ON mydb.mytable;
+
+
+-- Format SQL:
+CREATE ROW POLICY IF NOT EXISTS filter ON mydb.mytable FOR SELECT USING a < 1000 TO accountant, john@'localhost';
+CREATE ROW POLICY OR REPLACE p1 ON CLUSTER default_cluster ON mydb.table1 USING b = 1 AS RESTRICTIVE TO ALL EXCEPT mira;
+CREATE ROW POLICY p2 ON mydb.*, p3 ON mydb.* IN local_directory USING 1 AS PERMISSIVE TO NONE;
+ALTER ROW POLICY IF EXISTS filter ON mydb.mytable RENAME TO new_filter USING NONE TO ALL;
+ALTER ROW POLICY p1 ON mydb.table1 FOR SELECT USING c > 10 AND d IN (1, 2) AS PERMISSIVE;
+DROP ROW POLICY IF EXISTS p1 ON mydb.table1, p2 ON mydb.table1, p3 ON mydb.table2 ON CLUSTER default_cluster;
+DROP ROW POLICY filter ON mydb.mytable;
@@ -0,0 +1,26 @@
+-- Origin SQL:
+CREATE USER IF NOT EXISTS john ON CLUSTER default_cluster IDENTIFIED WITH sha256_password BY 'qwerty' HOST IP '192.168.0.0/16', LOCAL DEFAULT ROLE r1, r2;
+CREATE USER OR REPLACE mira HOST IP '127.0.0.1', '::1' IDENTIFIED BY 'qwerty';
+CREATE USER u1 NOT IDENTIFIED HOST ANY;
+CREATE USER u2 IDENTIFIED WITH ldap SERVER 'my_ldap_server' HOST NAME 'host.example.com', REGEXP '.*\.example\.com', LIKE '%.example.com';
+CREATE USER u3 IDENTIFIED WITH kerberos REALM 'EXAMPLE.COM' DEFAULT ROLE ALL EXCEPT r3;
+CREATE USER u4 IDENTIFIED WITH ssl_certificate CN 'mysite.com:user' VALID UNTIL '2025-01-01 12:00:00';
+CREATE USER u5@'%.example.com' IN local_directory DEFAULT DATABASE db GRANTEES ANY EXCEPT u1 SETTINGS max_memory_usage = 10000000 READONLY, PROFILE 'default';
+CREATE USER u6 SETTINGS max_memory_usage = 100 READONLY;
+CREATE USER u7 SETTINGS max_memory_usage = 100 readonly, max_threads = 4 CHANGEABLE_IN_READONLY, force_index_by_date CONST;
+CREATE USER u8 IDENTIFIED WITH plaintext_password BY '1', bcrypt_password BY '2', sha256_password BY '3' HOST LOCAL;
+CREATE USER u9 IDENTIFIED BY '1', BY '2';
+
+
+-- Format SQL:
+CREATE USER IF NOT EXISTS john ON CLUSTER default_cluster IDENTIFIED WITH sha256_password BY 'qwerty' HOST IP '192.168.0.0/16', LOCAL DEFAULT ROLE r1, r2;
+CREATE USER OR REPLACE mira IDENTIFIED BY 'qwerty' HOST IP '127.0.0.1', '::1';
+CREATE USER u1 NOT IDENTIFIED HOST ANY;
+CREATE USER u2 IDENTIFIED WITH ldap SERVER 'my_ldap_server' HOST NAME 'host.example.com', REGEXP '.*\\.example\\.com', LIKE '%.example.com';
+CREATE USER u3 IDENTIFIED WITH kerberos REALM 'EXAMPLE.COM' DEFAULT ROLE ALL EXCEPT r3;
+CREATE USER u4 IDENTIFIED WITH ssl_certificate CN 'mysite.com:user' VALID UNTIL '2025-01-01 12:00:00';
+CREATE USER u5@'%.example.com' IN local_directory DEFAULT DATABASE db GRANTEES ANY EXCEPT u1 SETTINGS max_memory_usage 10000000 READONLY, PROFILE 'default';
+CREATE USER u6 SETTINGS max_memory_usage 100 READONLY;
+CREATE USER u7 SETTINGS max_memory_usage 100 readonly, max_threads 4 CHANGEABLE_IN_READONLY, force_index_by_date CONST;
+CREATE USER u8 IDENTIFIED WITH plaintext_password BY '1', bcrypt_password BY '2', sha256_password BY '3' HOST LOCAL;
+CREATE USER u9 IDENTIFIED BY '1', BY '2';
@@ -0,0 +1,28 @@
+-- Origin SQL:
+CREATE WINDOW VIEW IF NOT EXISTS db.wv TO db.dst INNER ENGINE = AggregatingMergeTree ORDER BY w_start WATERMARK = INTERVAL '2' SECOND ALLOWED_LATENESS = INTERVAL '5' SECOND POPULATE AS SELECT count(number), tumbleStart(w_id) AS w_start FROM db.mt GROUP BY tumble(timestamp, INTERVAL '5' SECOND) AS w_id;
+CREATE WINDOW VIEW db.wv2 ENGINE = Memory WATERMARK = STRICTLY_ASCENDING AS SELECT count(number) FROM db.mt GROUP BY tumble(timestamp, INTERVAL '5' SECOND);
+
+
+-- Format SQL:
+CREATE WINDOW VIEW IF NOT EXISTS db.wv
+TO db.dst
+INNER ENGINE = AggregatingMergeTree
+ORDER BY w_start
+WATERMARK = INTERVAL '2' SECOND
+ALLOWED_LATENESS = INTERVAL '5' SECOND POPULATE AS (
+  SELECT 
+    count(number),
+    tumbleStart(w_id) AS w_start
+  FROM
+    db.mt
+  GROUP BY tumble(timestamp, INTERVAL '5' SECOND) AS w_id
+);
+CREATE WINDOW VIEW db.wv2
+ENGINE = Memory
+WATERMARK = STRICTLY_ASCENDING AS (
+  SELECT 
+    count(number)
+  FROM
+    db.mt
+  GROUP BY tumble(timestamp, INTERVAL '5' SECOND)
+);
@@ -1,6 +1,11 @@
 -- Origin SQL:
 DROP DATABASE IF EXISTS datbase_name;
+DROP DATABASE d ON CLUSTER 'default_cluster' SYNC;
+DROP DATABASE IF EXISTS d SYNC;
 
 
 -- Format SQL:
 DROP DATABASE IF EXISTS datbase_name;
+DROP DATABASE d
+  ON CLUSTER 'default_cluster' SYNC;
+DROP DATABASE IF EXISTS d SYNC;
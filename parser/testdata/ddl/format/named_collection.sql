@@ -0,0 +1,21 @@
+-- Origin SQL:
+CREATE NAMED COLLECTION s3_conn AS url = 'https://bucket.s3.amazonaws.com/', access_key_id = 'key' NOT OVERRIDABLE, max_connections = 10 OVERRIDABLE;
+CREATE NAMED COLLECTION IF NOT EXISTS kafka_conn ON CLUSTER 'default_cluster' AS kafka_broker_list = 'localhost:9092';
+ALTER NAMED COLLECTION s3_conn SET access_key_id = 'new_key' NOT OVERRIDABLE, secret_access_key = 'secret';
+ALTER NAMED COLLECTION IF EXISTS s3_conn ON CLUSTER 'default_cluster' SET url = 'https://other/' DELETE max_connections, access_key_id;
+ALTER NAMED COLLECTION s3_conn DELETE secret_access_key;
+DROP NAMED COLLECTION s3_conn;
+DROP NAMED COLLECTION IF EXISTS kafka_conn ON CLUSTER 'default_cluster';
+
+
+-- Format SQL:
+CREATE NAMED COLLECTION s3_conn AS url='https://bucket.s3.amazonaws.com/', access_key_id='key' NOT OVERRIDABLE, max_connections=10 OVERRIDABLE;
+CREATE NAMED COLLECTION IF NOT EXISTS kafka_conn
+ON CLUSTER 'default_cluster' AS kafka_broker_list='localhost:9092';
+ALTER NAMED COLLECTION s3_conn SET access_key_id='new_key' NOT OVERRIDABLE, secret_access_key='secret';
+ALTER NAMED COLLECTION IF EXISTS s3_conn
+ON CLUSTER 'default_cluster' SET url='https://other/' DELETE max_connections, access_key_id;
+ALTER NAMED COLLECTION s3_conn DELETE secret_access_key;
+DROP NAMED COLLECTION s3_conn;
+DROP NAMED COLLECTION IF EXISTS kafka_conn
+ON CLUSTER 'default_cluster';
@@ -7,6 +7,7 @@ OPTIMIZE TABLE table DEDUPLICATE BY * EXCEPT (colX, colY);
 OPTIMIZE TABLE table DEDUPLICATE BY COLUMNS('column-matched-by-regex');
 OPTIMIZE TABLE table DEDUPLICATE BY COLUMNS('column-matched-by-regex') EXCEPT colX;
 OPTIMIZE TABLE table DEDUPLICATE BY COLUMNS('column-matched-by-regex') EXCEPT (colX, colY);
+OPTIMIZE TABLE t FORMAT JSON;
 
 -- Format SQL:
 OPTIMIZE TABLE table DEDUPLICATE;
@@ -17,3 +18,5 @@ OPTIMIZE TABLE table DEDUPLICATE BY * EXCEPT (colX, colY);
 OPTIMIZE TABLE table DEDUPLICATE BY COLUMNS('column-matched-by-regex');
 OPTIMIZE TABLE table DEDUPLICATE BY COLUMNS('column-matched-by-regex') EXCEPT colX;
 OPTIMIZE TABLE table DEDUPLICATE BY COLUMNS('column-matched-by-regex') EXCEPT (colX, colY);
+OPTIMIZE TABLE t
+FORMAT JSON;
@@ -11,3 +11,8 @@ GRANT SELECT(x, y, z),INSERT ON database.table_1 TO table_1_select_role;
 GRANT SELECT, dictGet ON *.*  TO select_all_role;
 GRANT ADMIN OPTION ON *.*  TO select_all_role;
 
+GRANT ON CLUSTER 'my_cluster' SELECT(id, name), ALTER UPDATE ON db.users TO john WITH REPLACE OPTION;
+GRANT INTROSPECTION, SOURCES ON *.* TO john;
+GRANT accountant TO john;
+GRANT accountant, manager TO john, mary WITH ADMIN OPTION WITH REPLACE OPTION;
+GRANT r1 TO u1 WITH ADMIN OPTION
\ No newline at end of file
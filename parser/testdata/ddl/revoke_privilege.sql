@@ -0,0 +1,8 @@
+REVOKE SELECT ON db.table FROM john;
+REVOKE SELECT(x, y) ON db.table FROM john, mary;
+REVOKE ON CLUSTER 'my_cluster' INSERT, ALTER UPDATE ON *.* FROM ALL;
+REVOKE ALL ON *.* FROM ALL EXCEPT admin_role;
+REVOKE GRANT OPTION FOR SELECT ON db.* FROM CURRENT_USER;
+REVOKE INTROSPECTION ON *.* FROM john;
+REVOKE accountant FROM john;
+REVOKE ADMIN OPTION FOR accountant, manager FROM mary@'localhost';
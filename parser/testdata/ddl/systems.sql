@@ -1,3 +1,30 @@
 SYSTEM FLUSH LOGS;
 SYSTEM DROP UNCOMPRESSED CACHE;
 SYSTEM DROP FILESYSTEM CACHE;
+SYSTEM FLUSH DISTRIBUTED db.events_all;
+SYSTEM RELOAD DICTIONARY db.dict;
+SYSTEM RELOAD EMBEDDED DICTIONARIES;
+SYSTEM DROP COMPILED EXPRESSION CACHE;
+SYSTEM RESTART REPLICA db.events;
+SYSTEM RESTORE REPLICA db.events ON CLUSTER default_cluster;
+SYSTEM DROP REPLICA 'replica_1' FROM ZKPATH '/clickhouse/tables/01/events';
+SYSTEM DROP REPLICA 'replica_1' FROM TABLE db.events;
+SYSTEM DROP REPLICA 'replica_1' FROM DATABASE db;
+SYSTEM DROP REPLICA 'replica_1';
+SYSTEM STOP MERGES db.events;
+SYSTEM START MERGES;
+SYSTEM STOP TTL MERGES ON CLUSTER default_cluster db.events;
+SYSTEM START MOVES db.events;
+SYSTEM STOP FETCHES db.events;
+SYSTEM START REPLICATED SENDS;
+SYSTEM STOP DISTRIBUTED SENDS db.events_all;
+SYSTEM STOP REPLICATION QUEUES db.events;
+SYSTEM SYNC REPLICA db.events LIGHTWEIGHT;
+SYSTEM SYNC REPLICA ON CLUSTER default_cluster db.events STRICT;
+SYSTEM WAIT LOADING PARTS db.events;
+SYSTEM UNFREEZE WITH NAME 'backup_2024';
+SYSTEM REFRESH VIEW db.rollup_hourly;
+SYSTEM FLUSH LOGS FORMAT JSON;
+SYSTEM STOP MERGES ON VOLUME tiered.hot_volume;
+SYSTEM START MERGES ON CLUSTER default_cluster ON VOLUME tiered.hot_volume;
+SYSTEM STOP MERGES ON CLUSTER default_cluster db.events;
@@ -0,0 +1,18 @@
+-- Origin SQL:
+ALTER TABLE events UPDATE status = 'archived', updated_at = now() WHERE created_at < now() - 86400;
+ALTER TABLE db.events ON CLUSTER 'default' UPDATE visits = visits + 1 IN PARTITION 202401 WHERE user_id IN (1, 2, 3);
+ALTER TABLE events DELETE WHERE created_at < '2020-01-01';
+ALTER TABLE events DELETE IN PARTITION ID '202401' WHERE user_id = 42, DELETE WHERE user_id = 43;
+
+
+-- Format SQL:
+ALTER TABLE events
+UPDATE status = 'archived', updated_at = now() WHERE created_at < now() - 86400;
+ALTER TABLE db.events
+ON CLUSTER 'default'
+UPDATE visits = visits + 1 IN PARTITION 202401 WHERE user_id IN (1, 2, 3);
+ALTER TABLE events
+DELETE WHERE created_at < '2020-01-01';
+ALTER TABLE events
+DELETE IN PARTITION ID '202401' WHERE user_id = 42,
+DELETE WHERE user_id = 43;
@@ -0,0 +1,20 @@
+-- Origin SQL:
+BACKUP TABLE db.events, DATABASE logs EXCEPT TABLES logs.tmp, logs.debug TO Disk('backups', 'events.zip') SETTINGS compression_method = 'lzma', compression_level = 3 ASYNC;
+BACKUP TABLE db.events AS db.events_copy PARTITIONS '202401', '202402', DICTIONARY db.dict ON CLUSTER 'default_cluster' TO S3('https://bucket.s3.amazonaws.com/backups/1', 'key', 'secret');
+BACKUP ALL EXCEPT DATABASES system, information_schema TO File('all.zip') SETTINGS base_backup = File('base.zip');
+BACKUP TEMPORARY TABLE tmp, VIEW db.v TO Disk('backups', 'misc.zip') SYNC;
+RESTORE TABLE db.events AS db.events_restored, DATABASE logs AS logs_restored FROM Disk('backups', 'events.zip') SETTINGS allow_non_empty_tables = 1 ASYNC;
+RESTORE ALL FROM File('all.zip');
+RESTORE TABLE db.events FROM Disk('backups', 'events.zip') SETTINGS allow_non_empty_tables = true ASYNC;
+BACKUP DATABASE logs TO Disk('backups', 'logs.zip') SETTINGS async = false, compression_level = 3;
+
+
+-- Format SQL:
+BACKUP TABLE db.events, DATABASE logs EXCEPT TABLES logs.tmp, logs.debug TO Disk('backups','events.zip') SETTINGS compression_method='lzma', compression_level=3 ASYNC;
+BACKUP TABLE db.events AS db.events_copy PARTITIONS '202401', '202402', DICTIONARY db.dict ON CLUSTER 'default_cluster' TO S3('https://bucket.s3.amazonaws.com/backups/1','key','secret');
+BACKUP ALL EXCEPT DATABASES system, information_schema TO File('all.zip') SETTINGS base_backup=File('base.zip');
+BACKUP TEMPORARY TABLE tmp, VIEW db.v TO Disk('backups','misc.zip') SYNC;
+RESTORE TABLE db.events AS db.events_restored, DATABASE logs AS logs_restored FROM Disk('backups','events.zip') SETTINGS allow_non_empty_tables=1 ASYNC;
+RESTORE ALL FROM File('all.zip');
+RESTORE TABLE db.events FROM Disk('backups','events.zip') SETTINGS allow_non_empty_tables=true ASYNC;
+BACKUP DATABASE logs TO Disk('backups','logs.zip') SETTINGS async=false, compression_level=3;
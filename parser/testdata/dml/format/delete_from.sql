@@ -1,7 +1,13 @@
 -- Origin SQL:
 DELETE FROM hits WHERE Title LIKE '%hello%';
+DELETE FROM db.hits ON CLUSTER 'default' IN PARTITION '2024-01-01' WHERE CounterID = 62 SETTINGS lightweight_deletes_sync = 2;
 
 
 -- Format SQL:
 DELETE FROM hits
 WHERE Title LIKE '%hello%';
+DELETE FROM db.hits
+ON CLUSTER 'default'
+IN PARTITION '2024-01-01'
+WHERE CounterID = 62
+SETTINGS lightweight_deletes_sync=2;
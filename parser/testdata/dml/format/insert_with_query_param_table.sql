@@ -0,0 +1,15 @@
+-- Origin SQL:
+INSERT INTO {tbl:Identifier} (id, name) VALUES (1, 'a');
+INSERT INTO {db:Identifier}.{tbl:Identifier} SELECT * FROM {src:Identifier};
+
+
+-- Format SQL:
+INSERT INTO TABLE {tbl:Identifier}
+  (id, name)
+VALUES 
+  (1, 'a');
+INSERT INTO TABLE {db:Identifier}.{tbl:Identifier}
+SELECT 
+  *
+FROM
+  {src:Identifier};
@@ -0,0 +1,14 @@
+-- Origin SQL:
+UPDATE hits SET Title = 'Updated Title' WHERE EventDate = today();
+UPDATE db.hits ON CLUSTER 'default' SET Hits = Hits + 1, Title = upper(Title) IN PARTITION '2024-01-01' WHERE CounterID IN (1, 2);
+
+
+-- Format SQL:
+UPDATE hits
+SET Title = 'Updated Title'
+WHERE EventDate = today();
+UPDATE db.hits
+ON CLUSTER 'default'
+SET Hits = Hits + 1, Title = upper(Title)
+IN PARTITION '2024-01-01'
+WHERE CounterID IN (1, 2);
@@ -0,0 +1,9 @@
+INSERT INTO FUNCTION s3('https://bucket.s3.amazonaws.com/data.csv', 'CSV', 'a UInt32, b String') VALUES (1, 'x');
+INSERT INTO t SETTINGS async_insert=1, wait_for_async_insert=0 VALUES (1, 'a'), (2, 'b');
+INSERT INTO t (a, b) SELECT a, b FROM src SETTINGS max_threads=4;
+INSERT INTO t WITH x AS (SELECT 1) SELECT * FROM x;
+INSERT INTO t (a) WITH 1 AS one SELECT one UNION ALL SELECT 2;
+INSERT INTO t (a, b) SELECT a, b FROM src FORMAT JSON;
+INSERT INTO t (a, b) SETTINGS async_insert=1 FORMAT CSV;
+INSERT INTO db.events FORMAT JSONEachRow {"id": 1, "msg": "it's ; \"raw\""}
+{"id": 2, "msg": "SELECT"}
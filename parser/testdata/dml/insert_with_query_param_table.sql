@@ -0,0 +1,2 @@
+INSERT INTO {tbl:Identifier} (id, name) VALUES (1, 'a');
+INSERT INTO {db:Identifier}.{tbl:Identifier} SELECT * FROM {src:Identifier};
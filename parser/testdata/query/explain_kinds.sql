@@ -0,0 +1,11 @@
+EXPLAIN SELECT 1;
+EXPLAIN AST SELECT number FROM numbers(10);
+EXPLAIN SYNTAX SELECT * FROM t1 JOIN t2 ON t1.id = t2.id;
+EXPLAIN QUERY TREE SELECT id FROM t WHERE id > 1;
+EXPLAIN PLAN indexes = 1, actions = 1 SELECT count() FROM t WHERE d = today();
+EXPLAIN PIPELINE graph = 1, compact = 0 SELECT sum(number) FROM numbers_mt(100000) GROUP BY number % 4;
+EXPLAIN ESTIMATE SELECT * FROM t WHERE ts > now() - INTERVAL 1 DAY;
+EXPLAIN header = 1 SELECT 1 UNION ALL SELECT 2;
+EXPLAIN TABLE OVERRIDE mysql('127.0.0.1:3306', 'db', 'tbl', 'root', 'clickhouse') PARTITION BY toYYYYMM(created);
+EXPLAIN SYNTAX INSERT INTO t SELECT * FROM src;
+EXPLAIN AST CREATE TABLE t (id UInt64) ENGINE = MergeTree ORDER BY id;
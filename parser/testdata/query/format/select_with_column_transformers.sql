@@ -0,0 +1,60 @@
+-- Origin SQL:
+SELECT * EXCEPT (a, b) FROM t;
+SELECT * EXCEPT STRICT a FROM t;
+SELECT t.* REPLACE (x + 1 AS x, toString(y) AS y) FROM t;
+SELECT COLUMNS('^metric_') APPLY(sum) FROM t;
+SELECT COLUMNS(a, b) APPLY toString FROM t;
+SELECT * EXCEPT ('^tmp_') REPLACE (a * 2 AS a) APPLY(x -> x + 1) APPLY(quantile(0.9)) FROM t;
+SELECT DISTINCT ON (a, b) a, b, c FROM t ORDER BY a, b;
+SELECT * FROM t1 EXCEPT SELECT * FROM t2;
+
+
+-- Format SQL:
+
+SELECT 
+  * EXCEPT (a, b)
+FROM
+  t;
+
+SELECT 
+  * EXCEPT STRICT a
+FROM
+  t;
+
+SELECT 
+  t.* REPLACE (x + 1 AS x, toString(y) AS y)
+FROM
+  t;
+
+SELECT 
+  COLUMNS('^metric_') APPLY(sum)
+FROM
+  t;
+
+SELECT 
+  COLUMNS(a, b) APPLY toString
+FROM
+  t;
+
+SELECT 
+  * EXCEPT ('^tmp_') REPLACE (a * 2 AS a) APPLY(x -> x + 1) APPLY(quantile(0.9))
+FROM
+  t;
+
+SELECT DISTINCT ON (a, b) 
+  a,
+  b,
+  c
+FROM
+  t
+ORDER BY a, b;
+
+SELECT 
+  *
+FROM
+  t1
+ EXCEPT 
+SELECT 
+  *
+FROM
+  t2;
@@ -0,0 +1,71 @@
+-- Origin SQL:
+SELECT a, b, count() FROM t GROUP BY GROUPING SETS ((a), (a, b), ());
+SELECT a, b, count() FROM t GROUP BY ROLLUP(a, b);
+SELECT a, b, count() FROM t GROUP BY a, b WITH ROLLUP WITH TOTALS;
+SELECT a, b, count() FROM t GROUP BY ALL ORDER BY a DESC NULLS LAST, b NULLS FIRST;
+SELECT name FROM users ORDER BY name ASC COLLATE 'tr';
+SELECT toStartOfHour(ts) AS hour, count() AS cnt
+FROM events
+GROUP BY hour
+ORDER BY hour ASC WITH FILL FROM toStartOfHour(now() - INTERVAL 1 DAY) TO toStartOfHour(now()) STEP INTERVAL 1 HOUR
+INTERPOLATE (cnt AS cnt + 1);
+SELECT n, v FROM t ORDER BY n WITH FILL, v WITH FILL STEP 2 INTERPOLATE;
+
+
+-- Format SQL:
+
+SELECT 
+  a,
+  b,
+  count()
+FROM
+  t
+GROUP BY GROUPING SETS((a), (a, b), ());
+
+SELECT 
+  a,
+  b,
+  count()
+FROM
+  t
+GROUP BY ROLLUP(a, b);
+
+SELECT 
+  a,
+  b,
+  count()
+FROM
+  t
+GROUP BY a, b WITH ROLLUP WITH TOTALS;
+
+SELECT 
+  a,
+  b,
+  count()
+FROM
+  t
+GROUP BY ALL
+ORDER BY a DESC NULLS LAST, b NULLS FIRST;
+
+SELECT 
+  name
+FROM
+  users
+ORDER BY name ASC COLLATE 'tr';
+
+SELECT 
+  toStartOfHour(ts) AS hour,
+  count() AS cnt
+FROM
+  events
+GROUP BY hour
+ORDER BY hour ASC WITH FILL FROM toStartOfHour(now() - INTERVAL 1 DAY) TO toStartOfHour(now()) STEP INTERVAL 1 HOUR
+INTERPOLATE (cnt AS cnt + 1);
+
+SELECT 
+  n,
+  v
+FROM
+  t
+ORDER BY n WITH FILL, v WITH FILL STEP 2
+INTERPOLATE;
@@ -0,0 +1,22 @@
+-- Origin SQL:
+SELECT
+    arrayMap(x -> x * 2, arr) AS doubled,
+    arrayFilter((k, v) -> v > 0 AND k != '', keys, vals) AS filtered,
+    arrayReduce('sum', arrayMap(x -> toFloat64(x) / 100, amounts)) AS total,
+    arraySort((x, y) -> y, names, scores),
+    arrayExists(x -> x IN (1, 2, 3), ids),
+    arrayMap(x -> arrayMap(y -> x + y, range(3)), [1, 2])
+FROM sentio.events;
+
+
+-- Format SQL:
+
+SELECT 
+  arrayMap(x -> x * 2, arr) AS doubled,
+  arrayFilter((k, v) -> v > 0 AND k != '', keys, vals) AS filtered,
+  arrayReduce('sum', arrayMap(x -> toFloat64(x) / 100, amounts)) AS total,
+  arraySort((x, y) -> y, names, scores),
+  arrayExists(x -> x IN (1, 2, 3), ids),
+  arrayMap(x -> arrayMap(y -> x + y, range(3)), [1, 2])
+FROM
+  sentio.events;
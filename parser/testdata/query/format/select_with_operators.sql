@@ -0,0 +1,33 @@
+-- Origin SQL:
+SELECT *
+FROM events
+WHERE ts BETWEEN toDate('2024-01-01') AND toDate('2024-02-01') + 1
+  AND amount NOT BETWEEN 10 AND 100
+  AND name ILIKE '%abc%'
+  AND name NOT ILIKE 'x%'
+  AND name NOT LIKE 'y%'
+  AND url REGEXP '^https?://'
+  AND (a, b) IN ((1, 2), (3, 4))
+  AND user_id GLOBAL IN (SELECT id FROM users WHERE active)
+  AND user_id GLOBAL NOT IN blocked_users
+  AND org_id NOT IN db.orgs
+  AND a IS DISTINCT FROM b
+  AND c IS NOT DISTINCT FROM NULL
+  AND d IS NOT NULL;
+
+
+-- Format SQL:
+
+SELECT 
+  *
+FROM
+  events
+WHERE
+  ts BETWEEN toDate('2024-01-01') AND toDate('2024-02-01') + 1 AND amount NOT BETWEEN 10 AND 100 AND name ILIKE '%abc%' AND name NOT ILIKE 'x%' AND name NOT LIKE 'y%' AND url REGEXP '^https?://' AND (a, b) IN ((1, 2), (3, 4)) AND user_id GLOBAL IN (
+  SELECT 
+    id
+  FROM
+    users
+  WHERE
+    active
+) AND user_id GLOBAL NOT IN blocked_users AND org_id NOT IN db.orgs AND a IS DISTINCT FROM b AND c IS NOT DISTINCT FROM NULL AND d IS NOT NULL;
@@ -0,0 +1,20 @@
+-- Origin SQL:
+SELECT ts, {col:Identifier}, count() AS cnt
+FROM {tbl:Identifier}
+WHERE ts > {from:DateTime64(3)} AND ts <= {to:DateTime64(3, 'UTC')} AND name IN {names:Array(String)} AND status = {status:LowCardinality(Nullable(String))}
+GROUP BY ts
+LIMIT {limit:UInt32};
+
+
+-- Format SQL:
+
+SELECT 
+  ts,
+  {col:Identifier},
+  count() AS cnt
+FROM
+  {tbl:Identifier}
+WHERE
+  ts > {from:DateTime64(3)} AND ts <= {to:DateTime64(3,'UTC')} AND name IN {names:Array(String)} AND status = {status:LowCardinality(Nullable(String))}
+GROUP BY ts
+LIMIT {limit:UInt32};
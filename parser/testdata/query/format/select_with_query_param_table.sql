@@ -0,0 +1,17 @@
+-- Origin SQL:
+SELECT * FROM db.{tbl:Identifier};
+SELECT a.id FROM {db:Identifier}.{tbl:Identifier} AS a JOIN {other:Identifier} AS b ON a.id = b.id;
+
+
+-- Format SQL:
+
+SELECT 
+  *
+FROM
+  db.{tbl:Identifier};
+
+SELECT 
+  a.id
+FROM
+  {db:Identifier}.{tbl:Identifier} AS a
+  JOIN {other:Identifier} AS b ON a.id = b.id;
@@ -0,0 +1,119 @@
+-- Origin SQL:
+SELECT a FROM t1 UNION SELECT a FROM t2;
+SELECT a FROM t1 UNION ALL SELECT a FROM t2 INTERSECT SELECT a FROM t3;
+SELECT a FROM t1 INTERSECT DISTINCT SELECT a FROM t2 EXCEPT ALL SELECT a FROM t3;
+(SELECT a FROM t1) UNION ALL (SELECT a FROM t2 LIMIT 1) ORDER BY a LIMIT 10;
+SELECT a FROM t1 UNION ALL SELECT a FROM t2 ORDER BY a;
+(SELECT a FROM t1 UNION DISTINCT SELECT a FROM t2) INTERSECT SELECT a FROM t3;
+SELECT * FROM (SELECT a FROM t1 UNION ALL SELECT a FROM t2) WHERE a IN (SELECT a FROM t3 EXCEPT SELECT a FROM t4);
+
+
+-- Format SQL:
+
+SELECT 
+  a
+FROM
+  t1
+ UNION 
+SELECT 
+  a
+FROM
+  t2;
+
+SELECT 
+  a
+FROM
+  t1
+ UNION ALL 
+SELECT 
+  a
+FROM
+  t2
+ INTERSECT 
+SELECT 
+  a
+FROM
+  t3;
+
+SELECT 
+  a
+FROM
+  t1
+ INTERSECT DISTINCT 
+SELECT 
+  a
+FROM
+  t2
+ EXCEPT ALL 
+SELECT 
+  a
+FROM
+  t3;
+(
+  SELECT 
+    a
+  FROM
+    t1
+)
+ UNION ALL (
+  SELECT 
+    a
+  FROM
+    t2
+  LIMIT 1
+)
+ORDER BY a
+LIMIT 10;
+
+SELECT 
+  a
+FROM
+  t1
+ UNION ALL 
+SELECT 
+  a
+FROM
+  t2
+ORDER BY a;
+(
+  SELECT 
+    a
+  FROM
+    t1
+   UNION DISTINCT 
+  SELECT 
+    a
+  FROM
+    t2
+)
+ INTERSECT 
+SELECT 
+  a
+FROM
+  t3;
+
+SELECT 
+  *
+FROM (
+  
+    SELECT 
+      a
+    FROM
+      t1
+     UNION ALL 
+    SELECT 
+      a
+    FROM
+      t2)
+WHERE
+  a IN (
+  SELECT 
+    a
+  FROM
+    t3
+   EXCEPT 
+  SELECT 
+    a
+  FROM
+    t4
+);
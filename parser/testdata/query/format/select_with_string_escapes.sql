@@ -0,0 +1,28 @@
+-- Origin SQL:
+SELECT 'it''s', 'a\'b', 'C:\\path', 'tab\there', 'line\nbreak', '\x41\x42', '\u00e9t\u00e9', 'unknown\d', $$it's raw \n$$, $tag$SELECT 'x'$tag$, '';
+SELECT match(s, '\d+\.\w'), replaceRegexpOne(s, '^(\w+)\s', '\\1'), 'C:\\dir\\' FROM t WHERE s LIKE '100\%' AND name NOT LIKE 'a\_b%';
+
+
+-- Format SQL:
+
+SELECT 
+  'it\'s',
+  'a\'b',
+  'C:\\path',
+  'tab\there',
+  'line\nbreak',
+  'AB',
+  'été',
+  'unknown\\d',
+  'it\'s raw \\n',
+  'SELECT \'x\'',
+  '';
+
+SELECT 
+  match(s, '\\d+\\.\\w'),
+  replaceRegexpOne(s, '^(\\w+)\\s', '\\1'),
+  'C:\\dir\\'
+FROM
+  t
+WHERE
+  s LIKE '100\\%' AND name NOT LIKE 'a\\_b%';
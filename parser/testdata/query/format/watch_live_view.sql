@@ -0,0 +1,10 @@
+-- Origin SQL:
+WATCH db.lv;
+WATCH db.lv EVENTS LIMIT 1;
+WATCH lv LIMIT 2 FORMAT JSONEachRow;
+
+
+-- Format SQL:
+WATCH db.lv;
+WATCH db.lv EVENTS LIMIT 1;
+WATCH lv LIMIT 2 FORMAT JSONEachRow;
@@ -0,0 +1,3 @@
+SELECT 1 INTO OUTFILE 'x' FORMAT CSV;
+SELECT id, name FROM db.events WHERE id > 10 INTO OUTFILE 'events.csv' APPEND;
+SELECT a FROM t UNION ALL SELECT b FROM u INTO OUTFILE 'out.csv.gz' AND STDOUT TRUNCATE COMPRESSION 'gzip' LEVEL 3 FORMAT CSVWithNames;
@@ -0,0 +1,2 @@
+SELECT * FROM db.{tbl:Identifier};
+SELECT a.id FROM {db:Identifier}.{tbl:Identifier} AS a JOIN {other:Identifier} AS b ON a.id = b.id;
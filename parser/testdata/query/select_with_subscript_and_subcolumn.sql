@@ -0,0 +1,21 @@
+SELECT
+    arr[1],
+    attributes['user_id'] AS user_id,
+    m['a']['b'],
+    arrayMap(x -> x[2], nested)[1],
+    tup.1,
+    t.2.3,
+    tuple(1, 'a').2,
+    arr[1].name,
+    json.a.b,
+    json.a.b.c.d,
+    json.a.b.:Int64,
+    json.a.:Array(Nullable(String)),
+    events.col.size0,
+    {'a': 1, 'b': 2} AS m,
+    {} AS empty_map,
+    map('x', 1)['x'] + 1,
+    -arr[1],
+    0.5 + .5
+FROM events
+WHERE attributes['env'] = 'prod' AND tags[1] IN ('a', 'b');
@@ -0,0 +1,13 @@
+SELECT
+    user_id,
+    ts,
+    row_number() OVER w1 AS rn,
+    sum(amount) OVER w2 AS running_total,
+    first_value(amount) IGNORE NULLS OVER (w1 ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) AS first_amount,
+    last_value(amount) RESPECT NULLS OVER (PARTITION BY user_id ORDER BY ts GROUPS BETWEEN 1 PRECEDING AND 1 FOLLOWING) AS last_amount,
+    countIf(amount > 0) FILTER (WHERE status = 'ok') OVER w1 AS ok_count
+FROM events
+WINDOW w1 AS (PARTITION BY user_id ORDER BY ts), w2 AS (w1 ROWS BETWEEN 1 PRECEDING AND CURRENT ROW)
+QUALIFY rn = 1;
+SELECT count() FILTER (WHERE amount > 100) AS big, sum(amount) FILTER (WHERE amount < 0) FROM events;
+SELECT * FROM events QUALIFY row_number() OVER (PARTITION BY user_id ORDER BY ts DESC) = 1;
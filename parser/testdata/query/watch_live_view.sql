@@ -0,0 +1,3 @@
+WATCH db.lv;
+WATCH db.lv EVENTS LIMIT 1;
+WATCH lv LIMIT 2 FORMAT JSONEachRow;
@@ -127,5 +127,44 @@ func TestVisitor_NestRewrite(t *testing.T) {
 	newSql := stmt.String(0)
 
 	require.NotSame(t, sql, newSql)
-	require.Less(t, strings.Index(newSql, "table1"), strings.Index(newSql, "table2"))
+	// the queries of UNION DISTINCT are siblings, so both of them are at the first level
+	require.Less(t, strings.Index(newSql, "table1"), strings.LastIndex(newSql, "table1"))
+}
+
+type setOperationRewriteVisitor struct {
+	nestedRewriteVisitor
+}
+
+func (v *setOperationRewriteVisitor) enter(expr Expr) {
+	switch expr.(type) {
+	case *SelectQuery, *SetOperationExpr:
+		v.stack = append(v.stack, expr)
+	}
+}
+
+func (v *setOperationRewriteVisitor) leave(expr Expr) {
+	switch expr.(type) {
+	case *SelectQuery, *SetOperationExpr:
+		v.stack = v.stack[1:]
+	}
+}
+
+func TestVisitor_SetOperationRewrite(t *testing.T) {
+	visitor := setOperationRewriteVisitor{}
+
+	sql := `SELECT replica_name FROM system.ha_replicas UNION DISTINCT SELECT replica_name FROM system.ha_unique_replicas format JSON`
+	parser := NewParser(sql)
+	stmts, err := parser.ParseStatements()
+	require.NoError(t, err)
+
+	require.Equal(t, 1, len(stmts))
+	stmt := stmts[0]
+
+	err = stmt.Accept(&visitor)
+	require.NoError(t, err)
+	newSql := stmt.String(0)
+
+	// the queries are visited inside the UNION DISTINCT
+	require.NotContains(t, newSql, "table1")
+	require.Equal(t, 2, strings.Count(newSql, "table2"))
 }